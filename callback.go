@@ -0,0 +1,52 @@
+package telego
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+const autoAnswerTimeout = 5 * time.Second
+
+// Answer - answer the query with AnswerCallbackQuery and mark it as answered, opt may be nil.
+// CallbackQueryID is set from the query.
+func (q *CallbackQuery) Answer(bot *Bot, opt *AnswerCallbackQueryOpt) (bool, error) {
+	var answerOpt AnswerCallbackQueryOpt
+	if opt != nil {
+		answerOpt = *opt
+	}
+	answerOpt.CallbackQueryID = q.ID
+	atomic.StoreInt32(&q.answered, 1)
+	return bot.AnswerCallbackQuery(&answerOpt)
+}
+
+// Answered - the query was answered with Answer
+func (q *CallbackQuery) Answered() bool {
+	return atomic.LoadInt32(&q.answered) == 1
+}
+
+// AutoAnswer - call handler with the query and answer it without a notification after handler returns,
+// if handler did not answer it with Answer. The query is answered even if handler panics or ctx is done,
+// the answer is sent with a separate context.
+func (q *CallbackQuery) AutoAnswer(ctx context.Context, bot *Bot, handler CallbackQueryHandler) {
+	defer func() {
+		if q.Answered() {
+			return
+		}
+		answerCtx, cancel := context.WithTimeout(context.Background(), autoAnswerTimeout)
+		defer cancel()
+		_, err := q.Answer(bot.WithContext(answerCtx), nil)
+		if err != nil {
+			errLog("AutoAnswer Answer", err)
+		}
+	}()
+	handler(ctx, q)
+}
+
+// AutoAnswerCallbackQuery - wrap handler to answer every query it did not answer, can be used with
+// Router.OnCallbackQuery
+func AutoAnswerCallbackQuery(bot *Bot, handler CallbackQueryHandler) CallbackQueryHandler {
+	return func(ctx context.Context, q *CallbackQuery) {
+		q.AutoAnswer(ctx, bot, handler)
+	}
+}
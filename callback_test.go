@@ -0,0 +1,77 @@
+package telego
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCallbackQueryAnswer(t *testing.T) {
+	bot, forms := formStub(t, true)
+	query := &CallbackQuery{ID: "c1"}
+	opt := &AnswerCallbackQueryOpt{CallbackQueryID: "other", Text: "Done", ShowAlert: true}
+	ok, err := query.Answer(bot, opt)
+	if !ok || err != nil {
+		t.Fatalf("Answer() = %v, %v", ok, err)
+	}
+	form := (*forms)[0]
+	if form.Get("method") != "answerCallbackQuery" || form.Get("callback_query_id") != "c1" ||
+		form.Get("text") != "Done" || form.Get("show_alert") != "true" {
+		t.Errorf("answer = %v", form)
+	}
+	if opt.CallbackQueryID != "other" {
+		t.Error("options of the caller changed")
+	}
+	if !query.Answered() {
+		t.Error("query not marked as answered")
+	}
+}
+
+func TestAutoAnswer(t *testing.T) {
+	tests := []struct {
+		name      string
+		handler   func(bot *Bot) CallbackQueryHandler
+		cancel    bool
+		wantPanic bool
+		wantText  string
+	}{
+		{"not answered", func(bot *Bot) CallbackQueryHandler {
+			return func(ctx context.Context, q *CallbackQuery) {}
+		}, false, false, ""},
+		{"answered", func(bot *Bot) CallbackQueryHandler {
+			return func(ctx context.Context, q *CallbackQuery) {
+				q.Answer(bot.WithContext(ctx), &AnswerCallbackQueryOpt{Text: "Done"})
+			}
+		}, false, false, "Done"},
+		{"cancelled", func(bot *Bot) CallbackQueryHandler {
+			return func(ctx context.Context, q *CallbackQuery) { <-ctx.Done() }
+		}, true, false, ""},
+		{"panic", func(bot *Bot) CallbackQueryHandler {
+			return func(ctx context.Context, q *CallbackQuery) { panic("handler failed") }
+		}, false, true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, forms := formStub(t, true)
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancel {
+				cancel()
+			}
+			defer cancel()
+			query := &CallbackQuery{ID: "c1"}
+			func() {
+				defer func() {
+					if recovered := recover(); (recovered != nil) != tt.wantPanic {
+						t.Errorf("recovered %v, want panic %v", recovered, tt.wantPanic)
+					}
+				}()
+				AutoAnswerCallbackQuery(bot, tt.handler(bot))(ctx, query)
+			}()
+			if len(*forms) != 1 {
+				t.Fatalf("%d answers, want 1", len(*forms))
+			}
+			if form := (*forms)[0]; form.Get("callback_query_id") != "c1" || form.Get("text") != tt.wantText {
+				t.Errorf("answer = %v", form)
+			}
+		})
+	}
+}
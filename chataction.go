@@ -0,0 +1,55 @@
+package telego
+
+import (
+	"context"
+	"time"
+)
+
+// ChatAction - type of action to broadcast with SendChatAction
+type ChatAction string
+
+// Chat actions
+const (
+	ChatActionTyping          ChatAction = "typing"
+	ChatActionUploadPhoto     ChatAction = "upload_photo"
+	ChatActionRecordVideo     ChatAction = "record_video"
+	ChatActionUploadVideo     ChatAction = "upload_video"
+	ChatActionRecordAudio     ChatAction = "record_audio"
+	ChatActionUploadAudio     ChatAction = "upload_audio"
+	ChatActionUploadDocument  ChatAction = "upload_document"
+	ChatActionFindLocation    ChatAction = "find_location"
+	ChatActionRecordVideoNote ChatAction = "record_video_note"
+	ChatActionUploadVideoNote ChatAction = "upload_video_note"
+)
+
+// chatActionInterval - how often the action is resent, the status is shown for 5 seconds or less
+var chatActionInterval = 4500 * time.Millisecond
+
+// KeepChatAction - send the action to the chat now and resend it in a separate goroutine before the
+// status disappears, until the returned stop function is called or ctx is cancelled. Errors are logged,
+// they don't stop resending. stop can be called more than once.
+//
+// Usage in a slow handler:
+//
+//	stop := bot.KeepChatAction(ctx, chatID, ChatActionTyping)
+//	defer stop()
+func (bot *Bot) KeepChatAction(ctx context.Context, chatID string, action ChatAction) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	actionBot := bot.WithContext(ctx)
+	go func() {
+		ticker := time.NewTicker(chatActionInterval)
+		defer ticker.Stop()
+		for {
+			_, err := actionBot.SendChatAction(chatID, action)
+			if err != nil && ctx.Err() == nil {
+				errLog("KeepChatAction SendChatAction", err)
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return cancel
+}
@@ -0,0 +1,56 @@
+package telego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestKeepChatAction(t *testing.T) {
+	interval := chatActionInterval
+	chatActionInterval = 10 * time.Millisecond
+	defer func() { chatActionInterval = interval }()
+	tests := []struct {
+		name string
+		stop func(stop func(), cancel context.CancelFunc)
+	}{
+		{"stop", func(stop func(), cancel context.CancelFunc) { stop() }},
+		{"stop twice", func(stop func(), cancel context.CancelFunc) { stop(); stop() }},
+		{"context cancelled", func(stop func(), cancel context.CancelFunc) { cancel() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions := make(chan string, 100)
+			bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+				r.ParseForm()
+				if apiMethod(r) != "sendChatAction" || r.Form.Get("chat_id") != "42" {
+					t.Errorf("request %s %v", apiMethod(r), r.Form)
+				}
+				actions <- r.Form.Get("action")
+				writeResult(w, true)
+			})
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stop := bot.KeepChatAction(ctx, "42", ChatActionTyping)
+			for i := 0; i < 2; i++ {
+				select {
+				case action := <-actions:
+					if action != "typing" {
+						t.Errorf("action = %q, want typing", action)
+					}
+				case <-time.After(5 * time.Second):
+					t.Fatal("action not resent")
+				}
+			}
+			tt.stop(stop, cancel)
+			// a request sent before stopping can still arrive
+			time.Sleep(50 * time.Millisecond)
+			sent := len(actions)
+			time.Sleep(50 * time.Millisecond)
+			if len(actions) != sent {
+				t.Errorf("%d actions sent after stopping", len(actions)-sent)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package telego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// PreCheckoutWindow - time in which the Bot API must receive an answer to a pre-checkout query
+	PreCheckoutWindow = 10 * time.Second
+
+	checkoutTimeout         = 8 * time.Second
+	checkoutTimeoutMessage  = "Sorry, we could not confirm your order in time. Please try again later."
+	checkoutShippingMessage = "Sorry, we can't deliver to this address."
+	checkoutErrorMessage    = "Sorry, we could not complete your order."
+	checkoutOrderTTL        = time.Hour
+)
+
+// Order - state of a checkout, collected from the shipping query, the pre-checkout query and the
+// successful payment with the same invoice payload from the same user
+//
+// Payload				Bot specified invoice payload
+// User					User who makes the order
+// ShippingAddress		Optional. Shipping address from the shipping query
+// ShippingOptionID		Optional. Identifier of the chosen shipping option
+// OrderInfo			Optional. Order info provided by the user
+// Currency				Three-letter ISO 4217 currency code, set by the pre-checkout query
+// TotalAmount			Total price in the smallest units of the currency, set by the pre-checkout query
+// Payment				Optional. Successful payment, set when the payment is received
+type Order struct {
+	Payload          string
+	User             *User
+	ShippingAddress  *ShippingAddress
+	ShippingOptionID string
+	OrderInfo        *OrderInfo
+	Currency         string
+	TotalAmount      int
+	Payment          *SuccessfulPayment
+
+	updated time.Time
+}
+
+// Checkout - payment flow that answers shipping and pre-checkout queries and reports successful payments.
+// Queries and payments are correlated by the invoice payload and the user, so every callback gets the
+// Order with everything known about the checkout so far. Orders without a payment are dropped after an
+// hour of inactivity.
+//
+// Shipping is called for shipping queries, it returns the shipping options for the address or an error
+// whose text is shown to the user. If it returns no options the query is answered with a default error
+// message. If Shipping is nil shipping queries are not handled by the checkout, Middleware passes them
+// to the next handler.
+//
+// Validate is called for pre-checkout queries, it checks prices and inventory and returns an error whose
+// text is shown to the user if the order can't be completed. If Validate is nil every pre-checkout query
+// is confirmed.
+//
+// If Shipping or Validate does not return within Timeout (8 seconds by default) the query is answered with
+// TimeoutMessage, so the answer to a pre-checkout query is always sent within PreCheckoutWindow.
+//
+// Paid is called with the order and the service message when the payment is received.
+type Checkout struct {
+	Bot            *Bot
+	Shipping       func(ctx context.Context, order *Order, query *ShippingQuery) ([]*ShippingOption, error)
+	Validate       func(ctx context.Context, order *Order, query *PreCheckoutQuery) error
+	Paid           func(ctx context.Context, order *Order, message *Message)
+	Timeout        time.Duration
+	TimeoutMessage string
+
+	mu     sync.Mutex
+	orders map[string]*Order
+}
+
+// NewCheckout - create a checkout that answers queries with bot
+func NewCheckout(bot *Bot) *Checkout {
+	return &Checkout{
+		Bot:    bot,
+		orders: make(map[string]*Order),
+	}
+}
+
+// Middleware - middleware that handles shipping queries, pre-checkout queries and messages with a
+// successful payment with the checkout, other updates and shipping queries without Shipping are passed
+// to the next handler
+func (c *Checkout) Middleware() Middleware {
+	return func(next UpdateHandler) UpdateHandler {
+		return func(ctx context.Context, update Update) {
+			switch {
+			case update.ShippingQuery != nil && c.Shipping != nil:
+				c.HandleShippingQuery(ctx, update.ShippingQuery)
+			case update.PreCheckoutQuery != nil:
+				c.HandlePreCheckoutQuery(ctx, update.PreCheckoutQuery)
+			case update.Message != nil && update.Message.SuccessfulPayment != nil:
+				c.HandleMessage(ctx, update.Message)
+			default:
+				next(ctx, update)
+			}
+		}
+	}
+}
+
+// HandleShippingQuery - answer the shipping query with the options returned by Shipping, can be used with
+// Router.OnShippingQuery
+func (c *Checkout) HandleShippingQuery(ctx context.Context, query *ShippingQuery) {
+	if c.Shipping == nil {
+		return
+	}
+	order := c.order(query.From, query.InvoicePayload, func(order *Order) {
+		order.ShippingAddress = query.ShippingAddress
+	})
+	var options []*ShippingOption
+	err := c.guard(ctx, "Checkout Shipping", func(ctx context.Context) error {
+		var err error
+		options, err = c.Shipping(ctx, order, query)
+		return err
+	})
+	opt := &AnswerShippingQueryOpt{ShippingQueryID: query.ID}
+	switch {
+	case err != nil:
+		opt.ErrorMessage = checkoutError(err)
+	case len(options) == 0:
+		opt.ErrorMessage = checkoutShippingMessage
+	default:
+		opt.Ok = true
+		opt.ShippingOptions = options
+	}
+	_, err = c.Bot.WithContext(ctx).AnswerShippingQuery(opt)
+	if err != nil {
+		errLog("Checkout AnswerShippingQuery", err)
+	}
+}
+
+// HandlePreCheckoutQuery - answer the pre-checkout query with the result of Validate, can be used with
+// Router.OnPreCheckoutQuery. The answer is sent even if ctx is cancelled or Validate hangs.
+func (c *Checkout) HandlePreCheckoutQuery(ctx context.Context, query *PreCheckoutQuery) {
+	start := time.Now()
+	order := c.order(query.From, query.InvoicePayload, func(order *Order) {
+		order.ShippingOptionID = query.ShippingOptionID
+		order.OrderInfo = query.OrderInfo
+		order.Currency = query.Currency
+		order.TotalAmount = query.TotalAmount
+	})
+	opt := &AnswerPreCheckoutQueryOpt{PreCheckoutQueryID: query.ID, Ok: true}
+	if c.Validate != nil {
+		err := c.guard(ctx, "Checkout Validate", func(ctx context.Context) error {
+			return c.Validate(ctx, order, query)
+		})
+		if err != nil {
+			opt.Ok = false
+			opt.ErrorMessage = checkoutError(err)
+		}
+	}
+	answerCtx, cancel := context.WithTimeout(context.Background(), PreCheckoutWindow-time.Since(start))
+	defer cancel()
+	_, err := c.Bot.WithContext(answerCtx).AnswerPreCheckoutQuery(opt)
+	if err != nil {
+		errLog("Checkout AnswerPreCheckoutQuery", err)
+	}
+}
+
+// HandleMessage - report the successful payment in the message with Paid and forget the order, returns
+// false if the message has no successful payment
+func (c *Checkout) HandleMessage(ctx context.Context, message *Message) bool {
+	payment := message.SuccessfulPayment
+	if payment == nil {
+		return false
+	}
+	order := c.order(message.From, payment.InvoicePayload, func(order *Order) {
+		order.ShippingOptionID = payment.ShippingOptionID
+		order.OrderInfo = payment.OrderInfo
+		order.Currency = payment.Currency
+		order.TotalAmount = payment.TotalAmount
+		order.Payment = payment
+	})
+	c.mu.Lock()
+	delete(c.orders, orderKey(message.From, payment.InvoicePayload))
+	c.mu.Unlock()
+	if c.Paid != nil {
+		c.Paid(ctx, order, message)
+	}
+	return true
+}
+
+// guard - run the callback with a deadline, a hung or panicking callback fails the order
+func (c *Checkout) guard(ctx context.Context, name string, callback func(ctx context.Context) error) error {
+	timeout := c.Timeout
+	if timeout <= 0 || timeout >= PreCheckoutWindow {
+		timeout = checkoutTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	result := make(chan error, 1)
+	go func() {
+		defer func() {
+			recovered := recover()
+			if recovered != nil {
+				errLog(name, fmt.Errorf("panic: %v", recovered))
+				result <- errors.New(c.timeoutMessage())
+			}
+		}()
+		result <- callback(ctx)
+	}()
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		errLog(name, ctx.Err())
+		return errors.New(c.timeoutMessage())
+	}
+}
+
+func (c *Checkout) timeoutMessage() string {
+	if c.TimeoutMessage != "" {
+		return c.TimeoutMessage
+	}
+	return checkoutTimeoutMessage
+}
+
+// checkoutError - text of err shown to the user, a default message if it is empty
+func checkoutError(err error) string {
+	if err.Error() == "" {
+		return checkoutErrorMessage
+	}
+	return err.Error()
+}
+
+// order - find or create the order of the user with the payload and update it, the returned order is a
+// copy that is safe to use in callbacks
+func (c *Checkout) order(user *User, payload string, update func(order *Order)) *Order {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.orders == nil {
+		c.orders = make(map[string]*Order)
+	}
+	now := time.Now()
+	for key, order := range c.orders {
+		if now.Sub(order.updated) > checkoutOrderTTL {
+			delete(c.orders, key)
+		}
+	}
+	key := orderKey(user, payload)
+	order, ok := c.orders[key]
+	if !ok {
+		order = &Order{Payload: payload, User: user}
+		c.orders[key] = order
+	}
+	update(order)
+	order.updated = now
+	result := *order
+	return &result
+}
+
+func orderKey(user *User, payload string) string {
+	if user == nil {
+		return ":" + payload
+	}
+	return strconv.Itoa(user.ID) + ":" + payload
+}
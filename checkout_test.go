@@ -0,0 +1,188 @@
+package telego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCheckout(t *testing.T) {
+	var mu sync.Mutex
+	var forms []url.Values
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		r.Form.Set("method", apiMethod(r))
+		mu.Lock()
+		forms = append(forms, r.Form)
+		mu.Unlock()
+		writeResult(w, true)
+	})
+	lastForm := func() url.Values {
+		mu.Lock()
+		defer mu.Unlock()
+		return forms[len(forms)-1]
+	}
+	user := &User{ID: 7}
+	address := &ShippingAddress{City: "Berlin"}
+	var paid *Order
+	checkout := NewCheckout(bot)
+	checkout.Shipping = func(ctx context.Context, order *Order, query *ShippingQuery) ([]*ShippingOption, error) {
+		if order.ShippingAddress.City != "Berlin" {
+			return nil, errors.New("No delivery to " + order.ShippingAddress.City)
+		}
+		return []*ShippingOption{{ID: "post", Title: "Post"}}, nil
+	}
+	checkout.Validate = func(ctx context.Context, order *Order, query *PreCheckoutQuery) error {
+		if order.ShippingAddress != address || order.TotalAmount != 1500 {
+			return errors.New("Order changed")
+		}
+		return nil
+	}
+	checkout.Paid = func(ctx context.Context, order *Order, message *Message) {
+		paid = order
+	}
+	next := 0
+	handler := Chain(func(ctx context.Context, update Update) { next++ }, checkout.Middleware())
+	ctx := context.Background()
+
+	handler(ctx, Update{ShippingQuery: &ShippingQuery{ID: "s1", From: user, InvoicePayload: "order-1", ShippingAddress: address}})
+	if form := lastForm(); form.Get("method") != "answerShippingQuery" || form.Get("ok") != "true" {
+		t.Errorf("shipping answer = %v", form)
+	}
+	handler(ctx, Update{ShippingQuery: &ShippingQuery{ID: "s2", From: &User{ID: 8}, InvoicePayload: "order-1",
+		ShippingAddress: &ShippingAddress{City: "Paris"}}})
+	if form := lastForm(); form.Get("ok") != "false" || form.Get("error_message") != "No delivery to Paris" {
+		t.Errorf("shipping answer = %v", form)
+	}
+
+	handler(ctx, Update{PreCheckoutQuery: &PreCheckoutQuery{ID: "p1", From: user, InvoicePayload: "order-1",
+		Currency: "EUR", TotalAmount: 1500, ShippingOptionID: "post"}})
+	if form := lastForm(); form.Get("method") != "answerPreCheckoutQuery" || form.Get("ok") != "true" {
+		t.Errorf("pre-checkout answer = %v", form)
+	}
+
+	handler(ctx, Update{Message: &Message{From: user, SuccessfulPayment: &SuccessfulPayment{InvoicePayload: "order-1",
+		Currency: "EUR", TotalAmount: 1500, ShippingOptionID: "post"}}})
+	if paid == nil || paid.ShippingAddress != address || paid.ShippingOptionID != "post" || paid.Payment == nil {
+		t.Errorf("paid order = %+v", paid)
+	}
+	if len(checkout.orders) != 1 {
+		t.Errorf("%d orders left, want only the order of the other user", len(checkout.orders))
+	}
+
+	handler(ctx, Update{Message: &Message{From: user, Text: "thanks"}})
+	if next != 1 {
+		t.Errorf("next called %d times, want 1", next)
+	}
+}
+
+func TestCheckoutValidateTimeout(t *testing.T) {
+	answers := make(chan url.Values, 1)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		answers <- r.Form
+		writeResult(w, true)
+	})
+	release := make(chan struct{})
+	defer close(release)
+	checkout := NewCheckout(bot)
+	checkout.Timeout = 50 * time.Millisecond
+	checkout.TimeoutMessage = "Try again"
+	checkout.Validate = func(ctx context.Context, order *Order, query *PreCheckoutQuery) error {
+		<-release
+		return nil
+	}
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, ctx := range []context.Context{context.Background(), cancelled} {
+		start := time.Now()
+		checkout.HandlePreCheckoutQuery(ctx, &PreCheckoutQuery{ID: "p1", From: &User{ID: 7}, InvoicePayload: "order-1"})
+		if elapsed := time.Since(start); elapsed > PreCheckoutWindow {
+			t.Errorf("answered after %v", elapsed)
+		}
+		select {
+		case form := <-answers:
+			if form.Get("ok") != "false" || form.Get("error_message") != "Try again" {
+				t.Errorf("answer = %v", form)
+			}
+		default:
+			t.Fatal("pre-checkout query not answered")
+		}
+	}
+}
+
+func TestCheckoutValidatePanic(t *testing.T) {
+	answers := make(chan url.Values, 1)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		answers <- r.Form
+		writeResult(w, true)
+	})
+	checkout := NewCheckout(bot)
+	checkout.Validate = func(ctx context.Context, order *Order, query *PreCheckoutQuery) error {
+		panic("inventory is down")
+	}
+	checkout.HandlePreCheckoutQuery(context.Background(), &PreCheckoutQuery{ID: "p1", From: &User{ID: 7}})
+	if form := <-answers; form.Get("ok") != "false" || form.Get("error_message") != checkoutTimeoutMessage {
+		t.Errorf("answer = %v", form)
+	}
+}
+
+func TestCheckoutShipping(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	tests := []struct {
+		name      string
+		shipping  func(ctx context.Context, order *Order, query *ShippingQuery) ([]*ShippingOption, error)
+		wantCalls int
+		wantNext  int
+		wantOk    string
+		wantError string
+	}{
+		{"no callback", nil, 0, 1, "", ""},
+		{"options", func(ctx context.Context, order *Order, query *ShippingQuery) ([]*ShippingOption, error) {
+			return []*ShippingOption{{ID: "post", Title: "Post"}}, nil
+		}, 1, 0, "true", ""},
+		{"no options", func(ctx context.Context, order *Order, query *ShippingQuery) ([]*ShippingOption, error) {
+			return nil, nil
+		}, 1, 0, "false", checkoutShippingMessage},
+		{"error", func(ctx context.Context, order *Order, query *ShippingQuery) ([]*ShippingOption, error) {
+			return nil, errors.New("No delivery")
+		}, 1, 0, "false", "No delivery"},
+		{"empty error", func(ctx context.Context, order *Order, query *ShippingQuery) ([]*ShippingOption, error) {
+			return nil, errors.New("")
+		}, 1, 0, "false", checkoutErrorMessage},
+		{"timeout", func(ctx context.Context, order *Order, query *ShippingQuery) ([]*ShippingOption, error) {
+			<-release
+			return nil, nil
+		}, 1, 0, "false", "Try again"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, forms := formStub(t, true)
+			checkout := NewCheckout(bot)
+			checkout.Shipping = tt.shipping
+			checkout.Timeout = 50 * time.Millisecond
+			checkout.TimeoutMessage = "Try again"
+			next := 0
+			handler := Chain(func(ctx context.Context, update Update) { next++ }, checkout.Middleware())
+			handler(context.Background(), Update{ShippingQuery: &ShippingQuery{ID: "s1", From: &User{ID: 7},
+				InvoicePayload: "order-1", ShippingAddress: &ShippingAddress{City: "Berlin"}}})
+			if len(*forms) != tt.wantCalls || next != tt.wantNext {
+				t.Fatalf("%d requests and %d next calls, want %d and %d", len(*forms), next, tt.wantCalls, tt.wantNext)
+			}
+			if tt.wantCalls == 0 {
+				return
+			}
+			form := (*forms)[0]
+			if form.Get("method") != "answerShippingQuery" || form.Get("ok") != tt.wantOk ||
+				form.Get("error_message") != tt.wantError {
+				t.Errorf("answer = %v", form)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package telego
+
+import (
+	"context"
+	"strings"
+	"unicode/utf16"
+)
+
+// Command - bot command parsed from a message, e.g. /start@MyBot arg1 arg2
+//
+// Name		Command name without the leading slash and the bot username, in lower case
+// Mention	Optional. Username of the bot the command is addressed to
+// Args		Command arguments separated by whitespace
+// Message	Message containing the command
+type Command struct {
+	Name    string
+	Mention string
+	Args    []string
+	Message *Message
+}
+
+// CommandHandler - function that processes a bot command
+type CommandHandler func(ctx context.Context, command *Command)
+
+// ParseCommand - parse the bot_command entity at the beginning of the message text. Returns nil if the
+// message does not start with a command.
+func ParseCommand(message *Message) *Command {
+	if message == nil || message.Text == "" {
+		return nil
+	}
+	text := utf16.Encode([]rune(message.Text))
+	for _, entity := range message.Entities {
+		if entity == nil || entity.Type != "bot_command" || entity.Offset != 0 {
+			continue
+		}
+		if entity.Length < 2 || entity.Length > len(text) {
+			return nil
+		}
+		command := &Command{
+			Name:    string(utf16.Decode(text[1:entity.Length])),
+			Args:    strings.Fields(string(utf16.Decode(text[entity.Length:]))),
+			Message: message,
+		}
+		if i := strings.IndexByte(command.Name, '@'); i >= 0 {
+			command.Mention = command.Name[i+1:]
+			command.Name = command.Name[:i]
+		}
+		command.Name = strings.ToLower(command.Name)
+		return command
+	}
+	return nil
+}
+
+type commandEntry struct {
+	name        string
+	description string
+	handler     CommandHandler
+}
+
+// CommandRouter - dispatches bot commands to the registered handlers. Commands addressed to other bots
+// (/start@OtherBot) are ignored, all commands with a mention are ignored if bot.Self is not set.
+type CommandRouter struct {
+	bot      *Bot
+	commands map[string]*commandEntry
+	order    []*commandEntry
+	notFound CommandHandler
+}
+
+// NewCommandRouter - create new command router, bot.Self is used to check the command mention. Bots
+// created with NewBot have it set, without it commands like /start@MyBot are ignored.
+func NewCommandRouter(bot *Bot) *CommandRouter {
+	return &CommandRouter{
+		bot:      bot,
+		commands: make(map[string]*commandEntry),
+	}
+}
+
+// Handle - register handler for the command name (without the leading slash), description is used
+// in Help
+func (c *CommandRouter) Handle(name, description string, handler CommandHandler) {
+	name = strings.ToLower(strings.TrimPrefix(name, "/"))
+	if entry, ok := c.commands[name]; ok {
+		entry.description = description
+		entry.handler = handler
+		return
+	}
+	entry := &commandEntry{name: name, description: description, handler: handler}
+	c.commands[name] = entry
+	c.order = append(c.order, entry)
+}
+
+// NotFound - set handler for commands without a registered handler
+func (c *CommandRouter) NotFound(handler CommandHandler) {
+	c.notFound = handler
+}
+
+// Dispatch - pass the command from message to its handler. Returns false if message does not contain
+// a command for this bot or no handler was called.
+func (c *CommandRouter) Dispatch(ctx context.Context, message *Message) bool {
+	command := ParseCommand(message)
+	if command == nil {
+		return false
+	}
+	if command.Mention != "" && !c.mentionsSelf(command.Mention) {
+		return false
+	}
+	if entry, ok := c.commands[command.Name]; ok {
+		entry.handler(ctx, command)
+		return true
+	}
+	if c.notFound != nil {
+		c.notFound(ctx, command)
+		return true
+	}
+	return false
+}
+
+// mentionsSelf - the mention is the username of the bot, false if bot.Self is unknown
+func (c *CommandRouter) mentionsSelf(mention string) bool {
+	return c.bot != nil && c.bot.Self != nil && strings.EqualFold(mention, c.bot.Self.UserName)
+}
+
+// HandleMessage - MessageHandler calling Dispatch, can be used with Router.OnMessage
+func (c *CommandRouter) HandleMessage(ctx context.Context, message *Message) {
+	c.Dispatch(ctx, message)
+}
+
+// Help - list of registered commands with descriptions, one command per line in order of registration
+func (c *CommandRouter) Help() string {
+	lines := make([]string, 0, len(c.order))
+	for _, entry := range c.order {
+		line := "/" + entry.name
+		if entry.description != "" {
+			line += " - " + entry.description
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
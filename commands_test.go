@@ -0,0 +1,88 @@
+package telego
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func commandMessage(text string, entities ...*MessageEntity) *Message {
+	return &Message{Text: text, Entities: entities}
+}
+
+func botCommand(offset, length int) *MessageEntity {
+	return &MessageEntity{Type: "bot_command", Offset: offset, Length: length}
+}
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		message *Message
+		want    *Command
+	}{
+		{"nil message", nil, nil},
+		{"no entities", commandMessage("/start"), nil},
+		{"plain", commandMessage("/start", botCommand(0, 6)), &Command{Name: "start", Args: []string{}}},
+		{"args", commandMessage("/start  a b\tc", botCommand(0, 6)), &Command{Name: "start", Args: []string{"a", "b", "c"}}},
+		{"mention", commandMessage("/Start@MyBot x", botCommand(0, 12)), &Command{Name: "start", Mention: "MyBot", Args: []string{"x"}}},
+		{"not at start", commandMessage("hi /start", botCommand(3, 6)), nil},
+		{"other entity", commandMessage("/start", &MessageEntity{Type: "bold", Length: 6}), nil},
+		{"length too long", commandMessage("/a", botCommand(0, 5)), nil},
+		{"surrogate pairs in args", commandMessage("/echo 😀 ✓ x", botCommand(0, 5)), &Command{Name: "echo", Args: []string{"😀", "✓", "x"}}},
+		{"surrogate pairs in command", commandMessage("/😀😀 x", botCommand(0, 5)), &Command{Name: "😀😀", Args: []string{"x"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseCommand(tt.message)
+			if got != nil {
+				if got.Message != tt.message {
+					t.Errorf("Message not set")
+				}
+				got.Message = nil
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCommand() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandRouterMention(t *testing.T) {
+	tests := []struct {
+		name string
+		self *User
+		text string
+		want bool
+	}{
+		{"no mention", &User{UserName: "MyBot"}, "/start", true},
+		{"own mention", &User{UserName: "MyBot"}, "/start@mybot", true},
+		{"other bot", &User{UserName: "MyBot"}, "/start@OtherBot", false},
+		{"unknown self without mention", nil, "/start", true},
+		{"unknown self with mention", nil, "/start@MyBot", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := NewCommandRouter(&Bot{Self: tt.self})
+			called := false
+			router.Handle("/start", "", func(ctx context.Context, command *Command) {
+				called = true
+			})
+			message := commandMessage(tt.text, botCommand(0, len(tt.text)))
+			if got := router.Dispatch(context.Background(), message); got != tt.want || called != tt.want {
+				t.Errorf("Dispatch() = %v, called = %v, want %v", got, called, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandRouterHelp(t *testing.T) {
+	router := NewCommandRouter(nil)
+	handler := func(ctx context.Context, command *Command) {}
+	router.Handle("start", "Start the bot", handler)
+	router.Handle("/Help", "", handler)
+	router.Handle("start", "Start again", handler)
+	want := "/start - Start again\n/help"
+	if got := router.Help(); got != want {
+		t.Errorf("Help() = %q, want %q", got, want)
+	}
+}
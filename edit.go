@@ -0,0 +1,284 @@
+package telego
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// setMessageTarget - set the message to change: inline_message_id if it is not empty, otherwise chat_id
+// and message_id
+func setMessageTarget(values url.Values, chatID string, messageID int, inlineMessageID string) error {
+	if inlineMessageID != "" {
+		values.Set("inline_message_id", inlineMessageID)
+		return nil
+	}
+	if chatID == "" || messageID == 0 {
+		return ErrMissingParam
+	}
+	values.Set("chat_id", chatID)
+	values.Set("message_id", strconv.Itoa(messageID))
+	return nil
+}
+
+// messageOrTrue - the edited Message if the result is a message sent by the bot, nil if the result is
+// True returned for an inline message
+func messageOrTrue(result json.RawMessage) (*Message, error) {
+	var ok bool
+	if json.Unmarshal(result, &ok) == nil {
+		return nil, nil
+	}
+	var message Message
+	err := json.Unmarshal(result, &message)
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// EditMessageText - "editMessageText" Use this method to edit text and game messages sent by the bot or
+// via the bot (for inline bots). On success, if edited message is sent by the bot, the edited Message is
+// returned, otherwise True is returned and the returned message is nil.
+//
+// chat_id				Integer or	Optional	Required if inline_message_id is not specified. Unique identifier
+//						String					for the target chat or username of the target channel (in the
+//												format @channelusername)
+// message_id			Integer		Optional	Required if inline_message_id is not specified. Identifier of the
+//												sent message
+// inline_message_id	String		Optional	Required if chat_id and message_id are not specified. Identifier
+//												of the inline message
+// text					String		Yes			New text of the message
+// parse_mode			String		Optional	Send Markdown or HTML, if you want Telegram apps to show bold,
+//												italic, fixed-width text or inline URLs in your bot's message.
+// disable_web_page_preview
+//						Boolean		Optional	Disables link previews for links in this message
+// reply_markup	InlineKeyboardMarkup	Optional	A JSON-serialized object for an inline keyboard.
+func (bot *Bot) EditMessageText(opt *EditMessageTextOpt) (*Message, error) {
+	if opt.Text == "" {
+		return nil, ErrMissingParam
+	}
+	values := url.Values{}
+	err := setMessageTarget(values, opt.ChatID, opt.MessageID, opt.InlineMessageID)
+	if err != nil {
+		return nil, err
+	}
+	values.Set("text", opt.Text)
+	if opt.ParseMode != "" {
+		values.Set("parse_mode", opt.ParseMode)
+	}
+	if opt.DisableWebPagePreview {
+		values.Set("disable_web_page_preview", "true")
+	}
+	if opt.ReplyMarkup != nil {
+		err = setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("EditMessageText setJSONValue", err)
+			return nil, err
+		}
+	}
+	r, err := bot.createResponse("editMessageText", values)
+	if err != nil {
+		errLog("EditMessageText createResponse", err)
+		return nil, err
+	}
+	message, err := messageOrTrue(r.Result)
+	if err != nil {
+		errLog("EditMessageText messageOrTrue", err)
+	}
+	return message, err
+}
+
+// EditMessageCaption - "editMessageCaption" Use this method to edit captions of messages sent by the bot
+// or via the bot (for inline bots). On success, if edited message is sent by the bot, the edited Message
+// is returned, otherwise True is returned and the returned message is nil.
+//
+// chat_id				Integer or	Optional	Required if inline_message_id is not specified. Unique identifier
+//						String					for the target chat or username of the target channel (in the
+//												format @channelusername)
+// message_id			Integer		Optional	Required if inline_message_id is not specified. Identifier of the
+//												sent message
+// inline_message_id	String		Optional	Required if chat_id and message_id are not specified. Identifier
+//												of the inline message
+// caption				String		Optional	New caption of the message
+// reply_markup	InlineKeyboardMarkup	Optional	A JSON-serialized object for an inline keyboard.
+func (bot *Bot) EditMessageCaption(opt *EditMessageCaptionOpt) (*Message, error) {
+	values := url.Values{}
+	err := setMessageTarget(values, opt.ChatID, opt.MessageID, opt.InlineMessageID)
+	if err != nil {
+		return nil, err
+	}
+	if opt.Caption != "" {
+		values.Set("caption", opt.Caption)
+	}
+	if opt.ReplyMarkup != nil {
+		err = setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("EditMessageCaption setJSONValue", err)
+			return nil, err
+		}
+	}
+	r, err := bot.createResponse("editMessageCaption", values)
+	if err != nil {
+		errLog("EditMessageCaption createResponse", err)
+		return nil, err
+	}
+	message, err := messageOrTrue(r.Result)
+	if err != nil {
+		errLog("EditMessageCaption messageOrTrue", err)
+	}
+	return message, err
+}
+
+// EditMessageReplyMarkup - "editMessageReplyMarkup" Use this method to edit only the reply markup of
+// messages sent by the bot or via the bot (for inline bots). On success, if edited message is sent by
+// the bot, the edited Message is returned, otherwise True is returned and the returned message is nil.
+//
+// chat_id				Integer or	Optional	Required if inline_message_id is not specified. Unique identifier
+//						String					for the target chat or username of the target channel (in the
+//												format @channelusername)
+// message_id			Integer		Optional	Required if inline_message_id is not specified. Identifier of the
+//												sent message
+// inline_message_id	String		Optional	Required if chat_id and message_id are not specified. Identifier
+//												of the inline message
+// reply_markup	InlineKeyboardMarkup	Optional	A JSON-serialized object for an inline keyboard.
+func (bot *Bot) EditMessageReplyMarkup(opt *EditMessageReplyMarkupOpt) (*Message, error) {
+	values := url.Values{}
+	err := setMessageTarget(values, opt.ChatID, opt.MessageID, opt.InlineMessageID)
+	if err != nil {
+		return nil, err
+	}
+	if opt.ReplyMarkup != nil {
+		err = setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("EditMessageReplyMarkup setJSONValue", err)
+			return nil, err
+		}
+	}
+	r, err := bot.createResponse("editMessageReplyMarkup", values)
+	if err != nil {
+		errLog("EditMessageReplyMarkup createResponse", err)
+		return nil, err
+	}
+	message, err := messageOrTrue(r.Result)
+	if err != nil {
+		errLog("EditMessageReplyMarkup messageOrTrue", err)
+	}
+	return message, err
+}
+
+// EditMessageLiveLocation - "editMessageLiveLocation" Use this method to edit live location messages sent
+// by the bot or via the bot (for inline bots). A location can be edited until its live_period expires or
+// editing is explicitly disabled by a call to stopMessageLiveLocation. On success, if the edited message
+// was sent by the bot, the edited Message is returned, otherwise True is returned and the returned
+// message is nil.
+//
+// chat_id				Integer or	Optional	Required if inline_message_id is not specified. Unique identifier
+//						String					for the target chat or username of the target channel (in the
+//												format @channelusername)
+// message_id			Integer		Optional	Required if inline_message_id is not specified. Identifier of the
+//												sent message
+// inline_message_id	String		Optional	Required if chat_id and message_id are not specified. Identifier
+//												of the inline message
+// latitude				Float		Yes			Latitude of new location
+// longitude			Float		Yes			Longitude of new location
+// reply_markup	InlineKeyboardMarkup	Optional	A JSON-serialized object for an inline keyboard.
+func (bot *Bot) EditMessageLiveLocation(opt *EditMessageLiveLocationOpt) (*Message, error) {
+	if opt.Latitude == 0 || opt.Longitude == 0 {
+		return nil, ErrMissingParam
+	}
+	values := url.Values{}
+	err := setMessageTarget(values, opt.ChatID, opt.MessageID, opt.InlineMessageID)
+	if err != nil {
+		return nil, err
+	}
+	values.Set("latitude", strconv.FormatFloat(opt.Latitude, 'f', -1, 64))
+	values.Set("longitude", strconv.FormatFloat(opt.Longitude, 'f', -1, 64))
+	if opt.ReplyMarkup != nil {
+		err = setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("EditMessageLiveLocation setJSONValue", err)
+			return nil, err
+		}
+	}
+	r, err := bot.createResponse("editMessageLiveLocation", values)
+	if err != nil {
+		errLog("EditMessageLiveLocation createResponse", err)
+		return nil, err
+	}
+	message, err := messageOrTrue(r.Result)
+	if err != nil {
+		errLog("EditMessageLiveLocation messageOrTrue", err)
+	}
+	return message, err
+}
+
+// StopMessageLiveLocation - "stopMessageLiveLocation" Use this method to stop updating a live location
+// message sent by the bot or via the bot (for inline bots) before live_period expires. On success, if
+// the message was sent by the bot, the sent Message is returned, otherwise True is returned and the
+// returned message is nil.
+//
+// chat_id				Integer or	Optional	Required if inline_message_id is not specified. Unique identifier
+//						String					for the target chat or username of the target channel (in the
+//												format @channelusername)
+// message_id			Integer		Optional	Required if inline_message_id is not specified. Identifier of the
+//												sent message
+// inline_message_id	String		Optional	Required if chat_id and message_id are not specified. Identifier
+//												of the inline message
+// reply_markup	InlineKeyboardMarkup	Optional	A JSON-serialized object for an inline keyboard.
+func (bot *Bot) StopMessageLiveLocation(opt *StopMessageLiveLocationOpt) (*Message, error) {
+	values := url.Values{}
+	err := setMessageTarget(values, opt.ChatID, opt.MessageID, opt.InlineMessageID)
+	if err != nil {
+		return nil, err
+	}
+	if opt.ReplyMarkup != nil {
+		err = setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("StopMessageLiveLocation setJSONValue", err)
+			return nil, err
+		}
+	}
+	r, err := bot.createResponse("stopMessageLiveLocation", values)
+	if err != nil {
+		errLog("StopMessageLiveLocation createResponse", err)
+		return nil, err
+	}
+	message, err := messageOrTrue(r.Result)
+	if err != nil {
+		errLog("StopMessageLiveLocation messageOrTrue", err)
+	}
+	return message, err
+}
+
+// DeleteMessage - "deleteMessage" Use this method to delete a message, including service messages, with
+// the following limitations:
+// - A message can only be deleted if it was sent less than 48 hours ago.
+// - Bots can delete outgoing messages in groups and supergroups.
+// - Bots granted can_post_messages permissions can delete outgoing messages in channels.
+// - If the bot is an administrator of a group, it can delete any message there.
+// - If the bot has can_delete_messages permission in a supergroup or a channel, it can delete any
+// message there.
+// Returns True on success.
+//
+// chat_id		Integer or	Yes		Unique identifier for the target chat or username of the target
+//				String				channel (in the format @channelusername)
+// message_id	Integer		Yes		Identifier of the message to delete
+func (bot *Bot) DeleteMessage(chatID string, messageID int) (bool, error) {
+	if chatID == "" || messageID == 0 {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	values.Set("message_id", strconv.Itoa(messageID))
+	r, err := bot.createResponse("deleteMessage", values)
+	if err != nil {
+		errLog("DeleteMessage createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("DeleteMessage Unmarshal", err)
+	}
+	return result, err
+}
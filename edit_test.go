@@ -0,0 +1,85 @@
+package telego
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestSetMessageTarget(t *testing.T) {
+	tests := []struct {
+		name            string
+		chatID          string
+		messageID       int
+		inlineMessageID string
+		want            url.Values
+		wantErr         error
+	}{
+		{"chat message", "-100", 3, "", url.Values{"chat_id": {"-100"}, "message_id": {"3"}}, nil},
+		{"inline message", "", 0, "AAA", url.Values{"inline_message_id": {"AAA"}}, nil},
+		{"inline message first", "-100", 3, "AAA", url.Values{"inline_message_id": {"AAA"}}, nil},
+		{"no message", "-100", 0, "", url.Values{}, ErrMissingParam},
+		{"no chat", "", 3, "", url.Values{}, ErrMissingParam},
+		{"nothing", "", 0, "", url.Values{}, ErrMissingParam},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := url.Values{}
+			err := setMessageTarget(values, tt.chatID, tt.messageID, tt.inlineMessageID)
+			if err != tt.wantErr {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if values.Encode() != tt.want.Encode() {
+				t.Errorf("values = %v, want %v", values, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessageOrTrue(t *testing.T) {
+	tests := []struct {
+		name        string
+		result      string
+		wantMessage int
+		wantErr     bool
+	}{
+		{"true", `true`, 0, false},
+		{"message", `{"message_id":5,"text":"edited"}`, 5, false},
+		{"invalid", `"text"`, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, err := messageOrTrue(json.RawMessage(tt.result))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantMessage == 0 {
+				if message != nil {
+					t.Errorf("message = %+v, want nil", message)
+				}
+				return
+			}
+			if message == nil || message.MessageID != tt.wantMessage {
+				t.Errorf("message = %+v, want message %d", message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestEditMessageText(t *testing.T) {
+	bot, forms := formStub(t, true)
+	message, err := bot.EditMessageText(&EditMessageTextOpt{InlineMessageID: "AAA", Text: "edited"})
+	if err != nil || message != nil {
+		t.Errorf("EditMessageText() = %+v, %v for an inline message", message, err)
+	}
+	if form := (*forms)[0]; form.Get("method") != "editMessageText" || form.Get("inline_message_id") != "AAA" ||
+		form.Get("text") != "edited" {
+		t.Errorf("form = %v", form)
+	}
+	if _, err := bot.EditMessageText(&EditMessageTextOpt{ChatID: "-100", Text: "edited"}); err != ErrMissingParam {
+		t.Errorf("err = %v without message, want ErrMissingParam", err)
+	}
+	if len(*forms) != 1 {
+		t.Errorf("%d requests, want 1", len(*forms))
+	}
+}
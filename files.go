@@ -0,0 +1,218 @@
+package telego
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path/filepath"
+)
+
+// InputFile - This object represents a file to send. Exactly one of the fields FileID, URL, Path or
+// Reader must be set.
+//
+// FileID	file_id of a file that exists on the Telegram servers (recommended)
+// URL		HTTP URL for Telegram to get a file from the Internet
+// Path		Local path of a new file, the file is uploaded using multipart/form-data
+// Reader	Content of a new file, uploaded using multipart/form-data. A request with a reader is never
+//			repeated, because the reader can be read only once
+// Name		Optional. Name of the uploaded file, defaults to the base name of Path
+type InputFile struct {
+	FileID string
+	URL    string
+	Path   string
+	Reader io.Reader
+	Name   string
+}
+
+// NewInputFileID - file that exists on the Telegram servers
+func NewInputFileID(fileID string) *InputFile {
+	return &InputFile{FileID: fileID}
+}
+
+// NewInputFileURL - file Telegram gets from the Internet
+func NewInputFileURL(url string) *InputFile {
+	return &InputFile{URL: url}
+}
+
+// NewInputFilePath - new file uploaded from the local path
+func NewInputFilePath(path string) *InputFile {
+	return &InputFile{Path: path}
+}
+
+// NewInputFileReader - new file with name uploaded from the reader
+func NewInputFileReader(name string, reader io.Reader) *InputFile {
+	return &InputFile{Reader: reader, Name: name}
+}
+
+func (f *InputFile) empty() bool {
+	return f == nil || (f.FileID == "" && f.URL == "" && f.Path == "" && f.Reader == nil)
+}
+
+func (f *InputFile) isUpload() bool {
+	return f.Path != "" || f.Reader != nil
+}
+
+// value - string sent instead of an uploaded file
+func (f *InputFile) value() string {
+	if f.FileID != "" {
+		return f.FileID
+	}
+	return f.URL
+}
+
+func (f *InputFile) name() string {
+	if f.Name != "" {
+		return f.Name
+	}
+	if f.Path != "" {
+		return filepath.Base(f.Path)
+	}
+	return "file"
+}
+
+// write - copy the content of the file from reader to a new part of the multipart form
+func (f *InputFile) write(mw *multipart.Writer, field string, reader io.Reader) error {
+	part, err := mw.CreateFormFile(field, f.name())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, reader)
+	return err
+}
+
+// setInputFile - set key to the file_id or URL of file, or add it to the files to upload
+func setInputFile(values url.Values, files map[string]*InputFile, key string, file *InputFile) {
+	if file.isUpload() {
+		files[key] = file
+		return
+	}
+	values.Set(key, file.value())
+}
+
+// MaxDownloadSize - maximum size of a file bots can download, 20 MB
+const MaxDownloadSize = 20 << 20
+
+// Downloadable - received file that can be downloaded: *PhotoSize, *Document, *Audio, *Voice, *Video,
+// *VideoNote or *Sticker, nil pointers have no file
+type Downloadable interface {
+	fileInfo() (fileID string, fileSize int)
+}
+
+func (p *PhotoSize) fileInfo() (string, int) {
+	if p == nil {
+		return "", 0
+	}
+	return p.FileID, p.FileSize
+}
+
+func (d *Document) fileInfo() (string, int) {
+	if d == nil {
+		return "", 0
+	}
+	return d.FileID, d.FileSize
+}
+
+func (a *Audio) fileInfo() (string, int) {
+	if a == nil {
+		return "", 0
+	}
+	return a.FileID, a.FileSize
+}
+
+func (v *Voice) fileInfo() (string, int) {
+	if v == nil {
+		return "", 0
+	}
+	return v.FileID, v.FileSize
+}
+
+func (v *Video) fileInfo() (string, int) {
+	if v == nil {
+		return "", 0
+	}
+	return v.FileID, v.FileSize
+}
+
+func (v *VideoNote) fileInfo() (string, int) {
+	if v == nil {
+		return "", 0
+	}
+	return v.FileID, v.FileSize
+}
+
+func (s *Sticker) fileInfo() (string, int) {
+	if s == nil {
+		return "", 0
+	}
+	return s.FileID, s.FileSize
+}
+
+// DownloadFile - write the content of file received with GetFile to w. Returns ErrFileTooBig if the
+// file is larger than MaxDownloadSize.
+func (bot *Bot) DownloadFile(ctx context.Context, file File, w io.Writer) (int64, error) {
+	if file.FilePath == "" {
+		return 0, ErrMissingParam
+	}
+	if file.FileSize > MaxDownloadSize {
+		return 0, ErrFileTooBig
+	}
+	req, err := http.NewRequest(http.MethodGet, bot.fileURL(file.FilePath), nil)
+	if err != nil {
+		errLog("DownloadFile http.NewRequest", err)
+		return 0, err
+	}
+	resp, err := bot.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		errLog("DownloadFile bot.Client.Do", err)
+		return 0, err
+	}
+	defer func() {
+		err := resp.Body.Close()
+		if err != nil {
+			errLog("DownloadFile resp.Body.Close", err)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		err = &APIError{
+			Method:      "file",
+			StatusCode:  resp.StatusCode,
+			Description: http.StatusText(resp.StatusCode),
+		}
+		errLog("DownloadFile resp.StatusCode", err)
+		return 0, err
+	}
+	n, err := io.Copy(w, io.LimitReader(resp.Body, MaxDownloadSize+1))
+	if err != nil {
+		errLog("DownloadFile io.Copy", err)
+		return n, err
+	}
+	if n > MaxDownloadSize {
+		return n, ErrFileTooBig
+	}
+	return n, nil
+}
+
+// Download - get the file with GetFile and write its content to w. Returns ErrMissingParam if d is nil,
+// e.g. the message has no document.
+//
+//	_, err := bot.Download(ctx, message.Document, w)
+func (bot *Bot) Download(ctx context.Context, d Downloadable, w io.Writer) (int64, error) {
+	if d == nil {
+		return 0, ErrMissingParam
+	}
+	fileID, fileSize := d.fileInfo()
+	if fileID == "" {
+		return 0, ErrMissingParam
+	}
+	if fileSize > MaxDownloadSize {
+		return 0, ErrFileTooBig
+	}
+	file, err := bot.WithContext(ctx).GetFile(fileID)
+	if err != nil {
+		errLog("Download GetFile", err)
+		return 0, err
+	}
+	return bot.DownloadFile(ctx, file, w)
+}
@@ -0,0 +1,177 @@
+package telego
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type uploadedFile struct {
+	name    string
+	content string
+}
+
+// uploadStub - stub recording form values and uploaded files of multipart requests
+func uploadStub(t *testing.T, result interface{}) (*Bot, map[string]string, map[string]uploadedFile) {
+	values := make(map[string]string)
+	files := make(map[string]uploadedFile)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseMultipartForm(1 << 20)
+		if err != nil {
+			r.ParseForm()
+		}
+		for key := range r.Form {
+			values[key] = r.Form.Get(key)
+		}
+		if r.MultipartForm != nil {
+			for field, headers := range r.MultipartForm.File {
+				file, err := headers[0].Open()
+				if err != nil {
+					t.Error(err)
+					continue
+				}
+				content, _ := ioutil.ReadAll(file)
+				file.Close()
+				files[field] = uploadedFile{name: headers[0].Filename, content: string(content)}
+			}
+		}
+		writeResult(w, result)
+	})
+	return bot, values, files
+}
+
+func TestSendPhotoInputFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "photo.jpg")
+	err := os.WriteFile(path, []byte("from path"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		photo     *InputFile
+		wantValue string
+		wantFile  uploadedFile
+	}{
+		{"file id", NewInputFileID("AgAD"), "AgAD", uploadedFile{}},
+		{"url", NewInputFileURL("https://example.com/a.jpg"), "https://example.com/a.jpg", uploadedFile{}},
+		{"path", NewInputFilePath(path), "", uploadedFile{"photo.jpg", "from path"}},
+		{"reader", NewInputFileReader("b.jpg", strings.NewReader("from reader")), "", uploadedFile{"b.jpg", "from reader"}},
+		{"path with name", &InputFile{Path: path, Name: "c.jpg"}, "", uploadedFile{"c.jpg", "from path"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, values, files := uploadStub(t, Message{MessageID: 1})
+			_, err := bot.SendPhoto(&SendPhotoOpts{ChatID: "1", Photo: tt.photo, Caption: "caption"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if values["chat_id"] != "1" || values["caption"] != "caption" || values["photo"] != tt.wantValue {
+				t.Errorf("values = %v", values)
+			}
+			if files["photo"] != tt.wantFile {
+				t.Errorf("uploaded file = %+v, want %+v", files["photo"], tt.wantFile)
+			}
+		})
+	}
+}
+
+func TestSendPhotoMissingFile(t *testing.T) {
+	bot, values, _ := uploadStub(t, Message{})
+	_, err := bot.SendPhoto(&SendPhotoOpts{ChatID: "1", Photo: NewInputFilePath("/nonexistent/photo.jpg")})
+	if !os.IsNotExist(err) {
+		t.Errorf("err = %v, want not exist error", err)
+	}
+	if len(values) != 0 {
+		t.Error("request sent for a missing file")
+	}
+	if _, err := bot.SendPhoto(&SendPhotoOpts{ChatID: "1"}); err != ErrMissingParam {
+		t.Errorf("err = %v without photo, want ErrMissingParam", err)
+	}
+}
+
+// downloadStub - stub answering getFile with file and serving body with status at its file path
+func downloadStub(t *testing.T, file File, status int, body io.Reader) (*Bot, *[]string) {
+	var paths []string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path == "/botTOKEN/getFile" {
+			writeResult(w, file)
+			return
+		}
+		w.WriteHeader(status)
+		io.Copy(w, body)
+	})
+	return bot, &paths
+}
+
+func TestDownload(t *testing.T) {
+	tests := []struct {
+		name      string
+		d         Downloadable
+		file      File
+		status    int
+		body      io.Reader
+		wantPaths []string
+		wantN     int64
+		wantErr   error
+	}{
+		{"document", &Document{FileID: "BQAD", FileSize: 5}, File{FileID: "BQAD", FilePath: "documents/a.txt"},
+			http.StatusOK, strings.NewReader("hello"), []string{"/botTOKEN/getFile", "/file/botTOKEN/documents/a.txt"}, 5, nil},
+		{"nil", nil, File{}, http.StatusOK, nil, nil, 0, ErrMissingParam},
+		{"nil document", (*Document)(nil), File{}, http.StatusOK, nil, nil, 0, ErrMissingParam},
+		{"nil photo", (*PhotoSize)(nil), File{}, http.StatusOK, nil, nil, 0, ErrMissingParam},
+		{"too big", &Video{FileID: "BAAD", FileSize: MaxDownloadSize + 1}, File{}, http.StatusOK, nil, nil, 0, ErrFileTooBig},
+		{"too big file", &Voice{FileID: "AwAD"}, File{FileID: "AwAD", FilePath: "voice/a.ogg", FileSize: MaxDownloadSize + 1},
+			http.StatusOK, nil, []string{"/botTOKEN/getFile"}, 0, ErrFileTooBig},
+		{"body over limit", &Sticker{FileID: "CAAD"}, File{FileID: "CAAD", FilePath: "stickers/a.webp"},
+			http.StatusOK, io.LimitReader(zeroReader{}, MaxDownloadSize+10),
+			[]string{"/botTOKEN/getFile", "/file/botTOKEN/stickers/a.webp"}, MaxDownloadSize + 1, ErrFileTooBig},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, paths := downloadStub(t, tt.file, tt.status, tt.body)
+			n, err := bot.Download(context.Background(), tt.d, ioutil.Discard)
+			if err != tt.wantErr {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if n != tt.wantN {
+				t.Errorf("n = %d, want %d", n, tt.wantN)
+			}
+			if strings.Join(*paths, " ") != strings.Join(tt.wantPaths, " ") {
+				t.Errorf("requests = %q, want %q", *paths, tt.wantPaths)
+			}
+		})
+	}
+}
+
+func TestDownloadFileStatus(t *testing.T) {
+	bot, _ := downloadStub(t, File{}, http.StatusNotFound, strings.NewReader("not found"))
+	var buf bytes.Buffer
+	_, err := bot.DownloadFile(context.Background(), File{FilePath: "documents/a.txt"}, &buf)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("err = %v, want APIError with status 404", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %q of an error response", buf.String())
+	}
+	if _, err := bot.DownloadFile(context.Background(), File{}, &buf); err != ErrMissingParam {
+		t.Errorf("err = %v without file path, want ErrMissingParam", err)
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
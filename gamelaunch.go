@@ -0,0 +1,212 @@
+package telego
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const gameLaunchTTL = time.Hour
+
+// GameSession - parameters of a game launch signed in the launch URL
+//
+// GameShortName	Short name of the game
+// UserID			Identifier of the user who launched the game
+// ChatID			Optional. Identifier of the chat with the game message, 0 for inline messages
+// MessageID		Optional. Identifier of the game message, 0 for inline messages
+// InlineMessageID	Optional. Identifier of the inline game message
+// Expires			Time after which the launch URL is no longer valid
+type GameSession struct {
+	GameShortName   string
+	UserID          int
+	ChatID          int
+	MessageID       int
+	InlineMessageID string
+	Expires         time.Time
+}
+
+// GameLauncher - registry of games keyed by short name that answers callback queries from Play buttons
+// with signed per-user launch URLs. The URL of the game gets the parameters of the GameSession and an
+// HMAC-SHA256 signature made with Secret, the game server checks them with Verify, so it can call
+// SetGameScore for the right user and message. Launch URLs are valid for TTL, one hour by default.
+// Secret is required, it must be a random value kept private to the bot and the game server, otherwise
+// anyone can forge launch URLs. LaunchURL and Verify return ErrMissingParam if it is empty.
+//
+// Bot is used to answer callback queries, it may be nil if the launcher is used only by the game server
+// to verify URLs, callback queries are not answered then.
+type GameLauncher struct {
+	Bot    *Bot
+	Secret []byte
+	TTL    time.Duration
+
+	mu    sync.RWMutex
+	games map[string]string
+}
+
+// NewGameLauncher - create a launcher that answers callback queries with bot and signs URLs with secret,
+// secret must not be empty
+func NewGameLauncher(bot *Bot, secret []byte) *GameLauncher {
+	return &GameLauncher{
+		Bot:    bot,
+		Secret: secret,
+		games:  make(map[string]string),
+	}
+}
+
+// Register - set the URL of the game with the short name set up via Botfather
+func (l *GameLauncher) Register(shortName, gameURL string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.games == nil {
+		l.games = make(map[string]string)
+	}
+	l.games[shortName] = gameURL
+}
+
+// LaunchURL - URL of the registered game signed for the session, Expires is set from TTL if it is zero.
+// ChatID and MessageID are ignored if InlineMessageID is set. Returns ErrUnknownGame if the game is not
+// registered or ErrMissingParam if Secret is empty.
+func (l *GameLauncher) LaunchURL(session GameSession) (string, error) {
+	if len(l.Secret) == 0 {
+		return "", ErrMissingParam
+	}
+	l.mu.RLock()
+	gameURL, ok := l.games[session.GameShortName]
+	l.mu.RUnlock()
+	if !ok {
+		return "", ErrUnknownGame
+	}
+	u, err := url.Parse(gameURL)
+	if err != nil {
+		return "", err
+	}
+	if session.Expires.IsZero() {
+		ttl := l.TTL
+		if ttl <= 0 {
+			ttl = gameLaunchTTL
+		}
+		session.Expires = time.Now().Add(ttl)
+	}
+	values := u.Query()
+	values.Set("game", session.GameShortName)
+	values.Set("user_id", strconv.Itoa(session.UserID))
+	if session.InlineMessageID != "" {
+		session.ChatID, session.MessageID = 0, 0
+		values.Set("inline_message_id", session.InlineMessageID)
+	} else {
+		values.Set("chat_id", strconv.Itoa(session.ChatID))
+		values.Set("message_id", strconv.Itoa(session.MessageID))
+	}
+	values.Set("expires", strconv.FormatInt(session.Expires.Unix(), 10))
+	values.Set("signature", l.sign(session))
+	u.RawQuery = values.Encode()
+	return u.String(), nil
+}
+
+// Verify - check the signature and expiry of the query parameters of a launch URL and return the session.
+// Returns ErrInvalidSignature if the parameters were not signed with Secret, ErrExpiredSignature if the
+// URL is no longer valid or ErrMissingParam if Secret is empty.
+func (l *GameLauncher) Verify(values url.Values) (GameSession, error) {
+	if len(l.Secret) == 0 {
+		return GameSession{}, ErrMissingParam
+	}
+	session := GameSession{
+		GameShortName:   values.Get("game"),
+		InlineMessageID: values.Get("inline_message_id"),
+	}
+	var err error
+	session.UserID, err = strconv.Atoi(values.Get("user_id"))
+	if err != nil {
+		return GameSession{}, ErrInvalidSignature
+	}
+	if session.InlineMessageID == "" {
+		session.ChatID, err = strconv.Atoi(values.Get("chat_id"))
+		if err != nil {
+			return GameSession{}, ErrInvalidSignature
+		}
+		session.MessageID, err = strconv.Atoi(values.Get("message_id"))
+		if err != nil {
+			return GameSession{}, ErrInvalidSignature
+		}
+	}
+	expires, err := strconv.ParseInt(values.Get("expires"), 10, 64)
+	if err != nil {
+		return GameSession{}, ErrInvalidSignature
+	}
+	session.Expires = time.Unix(expires, 0)
+	if !hmac.Equal([]byte(values.Get("signature")), []byte(l.sign(session))) {
+		return GameSession{}, ErrInvalidSignature
+	}
+	if time.Now().After(session.Expires) {
+		return GameSession{}, ErrExpiredSignature
+	}
+	return session, nil
+}
+
+// Middleware - middleware that answers callback queries with a game short name with HandleCallbackQuery,
+// other updates are passed to the next handler
+func (l *GameLauncher) Middleware() Middleware {
+	return func(next UpdateHandler) UpdateHandler {
+		return func(ctx context.Context, update Update) {
+			if update.CallbackQuery != nil && update.CallbackQuery.GameShortName != "" {
+				l.HandleCallbackQuery(ctx, update.CallbackQuery)
+				return
+			}
+			next(ctx, update)
+		}
+	}
+}
+
+// HandleCallbackQuery - answer the callback query of a Play button with the launch URL of the game for
+// the user and the game message, can be used with Router.OnCallbackQuery. Queries without a game short
+// name or without Bot are not answered. A query for a game that is not registered is answered without a URL.
+func (l *GameLauncher) HandleCallbackQuery(ctx context.Context, query *CallbackQuery) {
+	if query.GameShortName == "" {
+		return
+	}
+	if l.Bot == nil {
+		errLog("GameLauncher HandleCallbackQuery "+query.GameShortName, ErrMissingParam)
+		return
+	}
+	session := GameSession{
+		GameShortName:   query.GameShortName,
+		InlineMessageID: query.InlineMessageID,
+	}
+	if query.From != nil {
+		session.UserID = query.From.ID
+	}
+	if query.Message != nil {
+		session.MessageID = query.Message.MessageID
+		if query.Message.Chat != nil {
+			session.ChatID = query.Message.Chat.ID
+		}
+	}
+	opt := &AnswerCallbackQueryOpt{}
+	launchURL, err := l.LaunchURL(session)
+	if err != nil {
+		errLog("GameLauncher LaunchURL "+query.GameShortName, err)
+	} else {
+		opt.URL = launchURL
+	}
+	_, err = query.Answer(l.Bot.WithContext(ctx), opt)
+	if err != nil {
+		errLog("GameLauncher Answer", err)
+	}
+}
+
+// sign - hex-encoded HMAC-SHA256 of the session parameters
+func (l *GameLauncher) sign(session GameSession) string {
+	mac := hmac.New(sha256.New, l.Secret)
+	mac.Write([]byte(session.GameShortName + "\n" +
+		strconv.Itoa(session.UserID) + "\n" +
+		strconv.Itoa(session.ChatID) + "\n" +
+		strconv.Itoa(session.MessageID) + "\n" +
+		session.InlineMessageID + "\n" +
+		strconv.FormatInt(session.Expires.Unix(), 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
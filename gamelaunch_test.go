@@ -0,0 +1,142 @@
+package telego
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestGameLauncherVerify(t *testing.T) {
+	launcher := NewGameLauncher(nil, []byte("secret"))
+	launcher.Register("snake", "https://games.example.com/snake?lang=en")
+	chatSession := GameSession{GameShortName: "snake", UserID: 7, ChatID: -100, MessageID: 3}
+	inlineSession := GameSession{GameShortName: "snake", UserID: 7, InlineMessageID: "AAA"}
+	launch := func(session GameSession) url.Values {
+		launchURL, err := launcher.LaunchURL(session)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := url.Parse(launchURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Host != "games.example.com" || u.Query().Get("lang") != "en" {
+			t.Errorf("launch URL = %s", launchURL)
+		}
+		return u.Query()
+	}
+	change := func(key, value string) func(url.Values) {
+		return func(values url.Values) { values.Set(key, value) }
+	}
+	tests := []struct {
+		name    string
+		session GameSession
+		modify  func(url.Values)
+		other   []byte
+		wantErr error
+	}{
+		{"chat message", chatSession, nil, nil, nil},
+		{"inline message", inlineSession, nil, nil, nil},
+		{"inline message with chat", GameSession{GameShortName: "snake", UserID: 7, ChatID: -100, MessageID: 3,
+			InlineMessageID: "AAA"}, nil, nil, nil},
+		{"other user", chatSession, change("user_id", "8"), nil, ErrInvalidSignature},
+		{"other chat", chatSession, change("chat_id", "-200"), nil, ErrInvalidSignature},
+		{"other message", chatSession, change("message_id", "4"), nil, ErrInvalidSignature},
+		{"other inline message", inlineSession, change("inline_message_id", "BBB"), nil, ErrInvalidSignature},
+		{"other game", chatSession, change("game", "tetris"), nil, ErrInvalidSignature},
+		{"longer expiry", chatSession, change("expires", strconv.FormatInt(time.Now().Add(24*time.Hour).Unix(), 10)),
+			nil, ErrInvalidSignature},
+		{"no signature", chatSession, func(values url.Values) { values.Del("signature") }, nil, ErrInvalidSignature},
+		{"invalid user id", chatSession, change("user_id", "x"), nil, ErrInvalidSignature},
+		{"other secret", chatSession, nil, []byte("other"), ErrInvalidSignature},
+		{"expired", GameSession{GameShortName: "snake", UserID: 7, ChatID: 1, MessageID: 1,
+			Expires: time.Now().Add(-time.Minute)}, nil, nil, ErrExpiredSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := launch(tt.session)
+			if tt.modify != nil {
+				tt.modify(values)
+			}
+			verifier := launcher
+			if tt.other != nil {
+				verifier = NewGameLauncher(nil, tt.other)
+			}
+			session, err := verifier.Verify(values)
+			if err != tt.wantErr {
+				t.Fatalf("Verify() err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			want := tt.session
+			if want.InlineMessageID != "" {
+				want.ChatID, want.MessageID = 0, 0
+			}
+			if session.UserID != want.UserID || session.ChatID != want.ChatID ||
+				session.MessageID != want.MessageID || session.InlineMessageID != want.InlineMessageID ||
+				session.GameShortName != "snake" || time.Until(session.Expires) <= 0 {
+				t.Errorf("Verify() = %+v, want %+v", session, want)
+			}
+		})
+	}
+}
+
+func TestGameLauncherSecretRequired(t *testing.T) {
+	launcher := NewGameLauncher(nil, nil)
+	launcher.Register("snake", "https://games.example.com/snake")
+	if _, err := launcher.LaunchURL(GameSession{GameShortName: "snake", UserID: 7}); err != ErrMissingParam {
+		t.Errorf("LaunchURL() err = %v, want ErrMissingParam", err)
+	}
+	values := url.Values{"game": {"snake"}, "user_id": {"7"}, "inline_message_id": {"A"}, "expires": {"9999999999"}}
+	if _, err := launcher.Verify(values); err != ErrMissingParam {
+		t.Errorf("Verify() err = %v, want ErrMissingParam", err)
+	}
+	if _, err := NewGameLauncher(nil, []byte("secret")).LaunchURL(GameSession{GameShortName: "tetris"}); err != ErrUnknownGame {
+		t.Errorf("LaunchURL() err = %v, want ErrUnknownGame", err)
+	}
+}
+
+func TestGameLauncherCallbackQuery(t *testing.T) {
+	answers := make(chan url.Values, 1)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		answers <- r.Form
+		writeResult(w, true)
+	})
+	launcher := NewGameLauncher(bot, []byte("secret"))
+	launcher.Register("snake", "https://games.example.com/snake")
+	next := 0
+	handler := Chain(func(ctx context.Context, update Update) { next++ }, launcher.Middleware())
+
+	query := &CallbackQuery{ID: "c1", From: &User{ID: 7}, Message: &Message{MessageID: 3, Chat: &Chat{ID: -100}},
+		GameShortName: "snake"}
+	handler(context.Background(), Update{CallbackQuery: query})
+	form := <-answers
+	u, err := url.Parse(form.Get("url"))
+	if err != nil || form.Get("callback_query_id") != "c1" {
+		t.Fatalf("answer = %v", form)
+	}
+	session, err := launcher.Verify(u.Query())
+	if err != nil || session.UserID != 7 || session.ChatID != -100 || session.MessageID != 3 {
+		t.Errorf("Verify() = %+v, %v", session, err)
+	}
+	if !query.Answered() {
+		t.Error("query not marked as answered")
+	}
+
+	handler(context.Background(), Update{CallbackQuery: &CallbackQuery{ID: "c2", Data: "button"}})
+	if next != 1 || len(answers) != 0 {
+		t.Errorf("callback query without a game: next called %d times, %d answers", next, len(answers))
+	}
+
+	verifier := NewGameLauncher(nil, []byte("secret"))
+	verifier.Register("snake", "https://games.example.com/snake")
+	verifier.HandleCallbackQuery(context.Background(), &CallbackQuery{ID: "c3", From: &User{ID: 7}, GameShortName: "snake"})
+	if len(answers) != 0 {
+		t.Error("callback query answered without Bot")
+	}
+}
@@ -19,7 +19,7 @@ import (
 //											launch the game.
 func (bot *Bot) SendGame(opt *SendGameOpt) (Message, error) {
 	values := url.Values{}
-	if opt.ChatID != "" || opt.GameShortName != "" {
+	if opt.ChatID == "" || opt.GameShortName == "" {
 		return Message{}, ErrMissingParam
 	}
 	values.Set("chat_id", opt.ChatID)
@@ -30,9 +30,17 @@ func (bot *Bot) SendGame(opt *SendGameOpt) (Message, error) {
 	if opt.ReplyToMessageID > 0 {
 		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
 	}
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendGame setJSONValue", err)
+			return Message{}, err
+		}
+	}
 	r, err := bot.createResponse("sendGame", values)
 	if err != nil {
 		errLog("SendGame createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
@@ -83,26 +91,88 @@ type Animation struct {
 // CallbackGame - A placeholder, currently holds no information. Use BotFather to set up your game.
 type CallbackGame struct{}
 
-// setGameScore
-// Use this method to set the score of the specified user in a game. On success, if the message was sent by the bot, returns the edited Message, otherwise returns True. Returns an error, if the new score is not greater than the user's current score in the chat and force is False.
+// SetGameScore - "setGameScore" Use this method to set the score of the specified user in a game. On success,
+// if the message was sent by the bot, returns the edited Message, otherwise returns True and the returned
+// message is nil. Returns an error, if the new score is not greater than the user's current score in the
+// chat and force is False.
 //
-// user_id	Integer	Yes	User identifier
-// score	Integer	Yes	New score, must be non-negative
-// force	Boolean	Optional	Pass True, if the high score is allowed to decrease. This can be useful when fixing mistakes or banning cheaters
-// disable_edit_message	Boolean	Optional	Pass True, if the game message should not be automatically edited to include the current scoreboard
-// chat_id	Integer	Optional	Required if inline_message_id is not specified. Unique identifier for the target chat
-// message_id	Integer	Optional	Required if inline_message_id is not specified. Identifier of the sent message
-// inline_message_id	String	Optional	Required if chat_id and message_id are not specified. Identifier of the inline message
-
-// getGameHighScores
-// Use this method to get data for high score tables. Will return the score of the specified user and several of his neighbors in a game. On success, returns an Array of GameHighScore objects.
+// user_id				Integer	Yes			User identifier
+// score				Integer	Yes			New score, must be non-negative
+// force				Boolean	Optional	Pass True, if the high score is allowed to decrease. This can be
+//											useful when fixing mistakes or banning cheaters
+// disable_edit_message	Boolean	Optional	Pass True, if the game message should not be automatically edited
+//											to include the current scoreboard
+// chat_id				Integer	Optional	Required if inline_message_id is not specified. Unique identifier for
+//											the target chat
+// message_id			Integer	Optional	Required if inline_message_id is not specified. Identifier of the
+//											sent message
+// inline_message_id	String	Optional	Required if chat_id and message_id are not specified. Identifier of
+//											the inline message
+func (bot *Bot) SetGameScore(opt *SetGameScoreOpt) (*Message, error) {
+	if opt.UserID == 0 || opt.Score < 0 {
+		return nil, ErrMissingParam
+	}
+	values := url.Values{}
+	err := setMessageTarget(values, opt.ChatID, opt.MessageID, opt.InlineMessageID)
+	if err != nil {
+		return nil, err
+	}
+	values.Set("user_id", strconv.Itoa(opt.UserID))
+	values.Set("score", strconv.Itoa(opt.Score))
+	if opt.Force {
+		values.Set("force", "true")
+	}
+	if opt.DisableEditMessage {
+		values.Set("disable_edit_message", "true")
+	}
+	r, err := bot.createResponse("setGameScore", values)
+	if err != nil {
+		errLog("SetGameScore createResponse", err)
+		return nil, err
+	}
+	message, err := messageOrTrue(r.Result)
+	if err != nil {
+		errLog("SetGameScore messageOrTrue", err)
+	}
+	return message, err
+}
 
-// This method will currently return scores for the target user, plus two of his closest neighbors on each side. Will also return the top three users if the user and his neighbors are not among them. Please note that this behavior is subject to change.
+// GetGameHighScores - "getGameHighScores" Use this method to get data for high score tables. Will return
+// the score of the specified user and several of his neighbors in a game. On success, returns an Array of
+// GameHighScore objects.
+// This method will currently return scores for the target user, plus two of his closest neighbors on each
+// side. Will also return the top three users if the user and his neighbors are not among them. Please note
+// that this behavior is subject to change.
 //
-// user_id	Integer	Yes	Target user id
-// chat_id	Integer	Optional	Required if inline_message_id is not specified. Unique identifier for the target chat
-// message_id	Integer	Optional	Required if inline_message_id is not specified. Identifier of the sent message
-// inline_message_id	String	Optional	Required if chat_id and message_id are not specified. Identifier of the inline message
+// user_id				Integer	Yes			Target user id
+// chat_id				Integer	Optional	Required if inline_message_id is not specified. Unique identifier for
+//											the target chat
+// message_id			Integer	Optional	Required if inline_message_id is not specified. Identifier of the
+//											sent message
+// inline_message_id	String	Optional	Required if chat_id and message_id are not specified. Identifier of
+//											the inline message
+func (bot *Bot) GetGameHighScores(opt *GetGameHighScoresOpt) ([]GameHighScore, error) {
+	if opt.UserID == 0 {
+		return nil, ErrMissingParam
+	}
+	values := url.Values{}
+	err := setMessageTarget(values, opt.ChatID, opt.MessageID, opt.InlineMessageID)
+	if err != nil {
+		return nil, err
+	}
+	values.Set("user_id", strconv.Itoa(opt.UserID))
+	r, err := bot.createResponse("getGameHighScores", values)
+	if err != nil {
+		errLog("GetGameHighScores createResponse", err)
+		return nil, err
+	}
+	var scores []GameHighScore
+	err = json.Unmarshal(r.Result, &scores)
+	if err != nil {
+		errLog("GetGameHighScores Unmarshal", err)
+	}
+	return scores, err
+}
 
 // GameHighScore - This object represents one row of the high scores table for a game.
 //
@@ -0,0 +1,35 @@
+package telego
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestInlineQueryResultType(t *testing.T) {
+	tests := []struct {
+		result InlineQueryResult
+		want   string
+	}{
+		{InlineQueryResultArticle{}, "article"},
+		{InlineQueryResultCachedPhoto{}, "photo"},
+		{InlineQueryResultPhoto{}, "photo"},
+		{InlineQueryResultCachedSticker{}, "sticker"},
+		{InlineQueryResultMpeg4Gif{}, "mpeg4_gif"},
+		{InlineQueryResultCachedMpeg4Gif{}, "mpeg4_gif"},
+		{InlineQueryResultGame{}, "game"},
+		{InlineQueryResultVenue{}, "venue"},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.result)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v struct {
+			Type string `json:"type"`
+		}
+		json.Unmarshal(data, &v)
+		if v.Type != tt.want {
+			t.Errorf("type of %T = %q, want %q", tt.result, v.Type, tt.want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package telego
+
+import (
+	"sort"
+	"sync"
+)
+
+// Leaderboard - local high score table of a game. Scores of the game backend are recorded with Set and
+// results of GetGameHighScores from different messages are merged with Merge, keeping the best score of
+// every user.
+type Leaderboard struct {
+	mu     sync.Mutex
+	scores map[int]GameHighScore
+}
+
+// NewLeaderboard - create an empty leaderboard
+func NewLeaderboard() *Leaderboard {
+	return &Leaderboard{scores: make(map[int]GameHighScore)}
+}
+
+// Set - record the score of the user, a lower score replaces the current one only if force is true.
+// Reports whether the score was changed, scores without a user are ignored.
+func (l *Leaderboard) Set(user *User, score int, force bool) bool {
+	if user == nil {
+		return false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.scores == nil {
+		l.scores = make(map[int]GameHighScore)
+	}
+	current, ok := l.scores[user.ID]
+	if ok && (current.Score == score || (current.Score > score && !force)) {
+		return false
+	}
+	l.scores[user.ID] = GameHighScore{User: user, Score: score}
+	return true
+}
+
+// Merge - merge high scores returned by GetGameHighScores, keeping the higher score of every user.
+// Returns the local scores that are higher than the merged ones, they should be sent with SetGameScore
+// to bring the scores in Telegram up to date.
+func (l *Leaderboard) Merge(scores []GameHighScore) []GameHighScore {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.scores == nil {
+		l.scores = make(map[int]GameHighScore)
+	}
+	var behind []GameHighScore
+	for _, score := range scores {
+		if score.User == nil {
+			continue
+		}
+		current, ok := l.scores[score.User.ID]
+		if ok && current.Score > score.Score {
+			behind = append(behind, current)
+			continue
+		}
+		l.scores[score.User.ID] = GameHighScore{User: score.User, Score: score.Score}
+	}
+	return behind
+}
+
+// Score - score of the user with the position in the table, false if the user has no score
+func (l *Leaderboard) Score(userID int) (GameHighScore, bool) {
+	for _, score := range l.Top(0) {
+		if score.User.ID == userID {
+			return score, true
+		}
+	}
+	return GameHighScore{}, false
+}
+
+// Top - the n best scores ordered by score with positions starting from 1, users with equal scores share
+// the position. All scores are returned if n <= 0.
+func (l *Leaderboard) Top(n int) []GameHighScore {
+	l.mu.Lock()
+	scores := make([]GameHighScore, 0, len(l.scores))
+	for _, score := range l.scores {
+		scores = append(scores, score)
+	}
+	l.mu.Unlock()
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].User.ID < scores[j].User.ID
+	})
+	for i := range scores {
+		scores[i].Position = i + 1
+		if i > 0 && scores[i].Score == scores[i-1].Score {
+			scores[i].Position = scores[i-1].Position
+		}
+	}
+	if n > 0 && n < len(scores) {
+		scores = scores[:n]
+	}
+	return scores
+}
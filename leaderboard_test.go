@@ -0,0 +1,87 @@
+package telego
+
+import "testing"
+
+func TestLeaderboardSet(t *testing.T) {
+	alice := &User{ID: 1}
+	board := NewLeaderboard()
+	steps := []struct {
+		name      string
+		user      *User
+		score     int
+		force     bool
+		want      bool
+		wantScore int
+	}{
+		{"first score", alice, 10, false, true, 10},
+		{"higher score", alice, 20, false, true, 20},
+		{"same score", alice, 20, true, false, 20},
+		{"lower score", alice, 5, false, false, 20},
+		{"forced lower score", alice, 5, true, true, 5},
+		{"no user", nil, 50, true, false, 5},
+	}
+	for _, step := range steps {
+		if got := board.Set(step.user, step.score, step.force); got != step.want {
+			t.Errorf("%s: Set() = %v, want %v", step.name, got, step.want)
+		}
+		if score, ok := board.Score(alice.ID); !ok || score.Score != step.wantScore {
+			t.Errorf("%s: score = %+v, want %d", step.name, score, step.wantScore)
+		}
+	}
+}
+
+func TestLeaderboardMerge(t *testing.T) {
+	alice, bob, carol := &User{ID: 1}, &User{ID: 2}, &User{ID: 3}
+	board := NewLeaderboard()
+	board.Set(alice, 30, false)
+	board.Set(bob, 10, false)
+	behind := board.Merge([]GameHighScore{
+		{Position: 1, User: bob, Score: 25},
+		{Position: 2, User: alice, Score: 20},
+		{Position: 3, User: carol, Score: 20},
+		{Position: 4, Score: 100},
+	})
+	if len(behind) != 1 || behind[0].User != alice || behind[0].Score != 30 {
+		t.Errorf("Merge() = %+v, want the local score of alice", behind)
+	}
+	tests := []struct {
+		n    int
+		want []GameHighScore
+	}{
+		{0, []GameHighScore{{1, alice, 30}, {2, bob, 25}, {3, carol, 20}}},
+		{2, []GameHighScore{{1, alice, 30}, {2, bob, 25}}},
+		{5, []GameHighScore{{1, alice, 30}, {2, bob, 25}, {3, carol, 20}}},
+	}
+	for _, tt := range tests {
+		top := board.Top(tt.n)
+		if len(top) != len(tt.want) {
+			t.Fatalf("Top(%d) = %+v, want %+v", tt.n, top, tt.want)
+		}
+		for i := range top {
+			if top[i] != tt.want[i] {
+				t.Errorf("Top(%d)[%d] = %+v, want %+v", tt.n, i, top[i], tt.want[i])
+			}
+		}
+	}
+	if _, ok := board.Score(4); ok {
+		t.Error("score of a user without a score")
+	}
+}
+
+func TestLeaderboardSharedPositions(t *testing.T) {
+	board := NewLeaderboard()
+	for id, score := range []int{10, 20, 20, 5, 10} {
+		board.Set(&User{ID: id + 1}, score, false)
+	}
+	var positions, users []int
+	for _, score := range board.Top(0) {
+		positions = append(positions, score.Position)
+		users = append(users, score.User.ID)
+	}
+	wantPositions, wantUsers := []int{1, 1, 3, 3, 5}, []int{2, 3, 1, 5, 4}
+	for i := range wantPositions {
+		if positions[i] != wantPositions[i] || users[i] != wantUsers[i] {
+			t.Fatalf("positions = %v of users %v, want %v of %v", positions, users, wantPositions, wantUsers)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package telego
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default send limits of the Bot API
+var (
+	DefaultGlobalRate  = Rate{Requests: 30, Per: time.Second}
+	DefaultPrivateRate = Rate{Requests: 1, Per: time.Second}
+	DefaultGroupRate   = Rate{Requests: 20, Per: time.Minute}
+)
+
+const limiterSweepSize = 1024
+
+// Rate - number of requests allowed per interval
+type Rate struct {
+	Requests int
+	Per      time.Duration
+}
+
+// Limiter - scheduler of outgoing messages keeping the Bot API send limits, assign to Bot.Limiter to
+// enable. Calls of send* and forwardMessage methods wait in a queue until both the global limit and the
+// limit of the target chat allow sending. Chats with a negative chat_id or a @username are treated as
+// groups and channels, others as private chats. Fields must not be changed after the first request.
+//
+// GlobalRate	Optional. Limit of all messages, defaults to 30 per second
+// PrivateRate	Optional. Limit of messages to one private chat, defaults to 1 per second
+// GroupRate	Optional. Limit of messages to one group or channel, defaults to 20 per minute
+type Limiter struct {
+	GlobalRate  Rate
+	PrivateRate Rate
+	GroupRate   Rate
+
+	mu      sync.Mutex
+	global  *bucket
+	chats   map[string]*bucket
+	queued  int
+	created int
+}
+
+// Queued - number of requests waiting for their turn
+func (l *Limiter) Queued() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.queued
+}
+
+// Wait - block until a message to chatID may be sent or ctx is done. An empty chatID is checked
+// against the global limit only.
+func (l *Limiter) Wait(ctx context.Context, chatID string) error {
+	l.mu.Lock()
+	l.queued++
+	l.mu.Unlock()
+	defer func() {
+		l.mu.Lock()
+		l.queued--
+		l.mu.Unlock()
+	}()
+	if chatID != "" {
+		err := l.wait(ctx, func() *bucket { return l.chatBucket(chatID) })
+		if err != nil {
+			return err
+		}
+	}
+	return l.wait(ctx, l.globalBucket)
+}
+
+// wait - take a token from the bucket, sleeping until it is available
+func (l *Limiter) wait(ctx context.Context, get func() *bucket) error {
+	l.mu.Lock()
+	b := get()
+	d := b.reserve(time.Now())
+	l.mu.Unlock()
+	if d <= 0 {
+		return nil
+	}
+	if !sleepContext(ctx, d) {
+		l.mu.Lock()
+		b.cancel()
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+	return nil
+}
+
+func (l *Limiter) globalBucket() *bucket {
+	if l.global == nil {
+		l.global = newBucket(l.GlobalRate, DefaultGlobalRate)
+	}
+	return l.global
+}
+
+func (l *Limiter) chatBucket(chatID string) *bucket {
+	if b, ok := l.chats[chatID]; ok {
+		return b
+	}
+	if l.chats == nil {
+		l.chats = make(map[string]*bucket)
+	}
+	l.created++
+	if l.created%limiterSweepSize == 0 {
+		l.sweep(time.Now())
+	}
+	var b *bucket
+	if strings.HasPrefix(chatID, "-") || strings.HasPrefix(chatID, "@") {
+		b = newBucket(l.GroupRate, DefaultGroupRate)
+	} else {
+		b = newBucket(l.PrivateRate, DefaultPrivateRate)
+	}
+	l.chats[chatID] = b
+	return b
+}
+
+// sweep - remove buckets of chats that are idle long enough to be full again
+func (l *Limiter) sweep(now time.Time) {
+	for chatID, b := range l.chats {
+		if b.full(now) {
+			delete(l.chats, chatID)
+		}
+	}
+}
+
+// isLimitedMethod - methods sending messages to a chat
+func isLimitedMethod(method string) bool {
+	method = strings.ToLower(method)
+	return method == "forwardmessage" || (strings.HasPrefix(method, "send") && method != "sendchataction")
+}
+
+// bucket - token bucket, tokens may become negative to reserve future slots for waiting requests
+type bucket struct {
+	tokens float64
+	burst  float64
+	rate   float64
+	last   time.Time
+}
+
+func newBucket(rate, def Rate) *bucket {
+	if rate.Requests <= 0 || rate.Per <= 0 {
+		rate = def
+	}
+	return &bucket{
+		tokens: float64(rate.Requests),
+		burst:  float64(rate.Requests),
+		rate:   float64(rate.Requests) / rate.Per.Seconds(),
+	}
+}
+
+func (b *bucket) advance(now time.Time) {
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+}
+
+// reserve - take a token, returns the time to wait until it is available
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.advance(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel - return a reserved token
+func (b *bucket) cancel() {
+	b.tokens++
+}
+
+func (b *bucket) full(now time.Time) bool {
+	b.advance(now)
+	return b.tokens >= b.burst
+}
@@ -0,0 +1,96 @@
+package telego
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func waitAll(t *testing.T, l *Limiter, chatIDs ...string) time.Duration {
+	t.Helper()
+	start := time.Now()
+	for _, chatID := range chatIDs {
+		err := l.Wait(context.Background(), chatID)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return time.Since(start)
+}
+
+func TestLimiterWait(t *testing.T) {
+	fast := Rate{Requests: 1000, Per: time.Second}
+	tests := []struct {
+		name    string
+		limiter *Limiter
+		chatIDs []string
+		min     time.Duration
+		max     time.Duration
+	}{
+		{"global burst", &Limiter{GlobalRate: Rate{Requests: 5, Per: 100 * time.Millisecond}},
+			[]string{"", "", "", "", ""}, 0, 50 * time.Millisecond},
+		{"global limit", &Limiter{GlobalRate: Rate{Requests: 5, Per: 100 * time.Millisecond}},
+			[]string{"", "", "", "", "", "", "", ""}, 50 * time.Millisecond, 200 * time.Millisecond},
+		{"private chat", &Limiter{GlobalRate: fast, PrivateRate: Rate{Requests: 1, Per: 50 * time.Millisecond}},
+			[]string{"1", "1", "1"}, 90 * time.Millisecond, 250 * time.Millisecond},
+		{"different chats", &Limiter{GlobalRate: fast, PrivateRate: Rate{Requests: 1, Per: time.Second}},
+			[]string{"1", "2", "3", "4"}, 0, 50 * time.Millisecond},
+		{"group", &Limiter{GlobalRate: fast, PrivateRate: Rate{Requests: 1, Per: time.Second},
+			GroupRate: Rate{Requests: 3, Per: time.Second}}, []string{"-1", "-1", "-1", "@channel"}, 0, 50 * time.Millisecond},
+		{"group limit", &Limiter{GlobalRate: fast, GroupRate: Rate{Requests: 2, Per: 100 * time.Millisecond}},
+			[]string{"-1", "-1", "-1"}, 40 * time.Millisecond, 200 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elapsed := waitAll(t, tt.limiter, tt.chatIDs...)
+			if elapsed < tt.min || elapsed > tt.max {
+				t.Errorf("elapsed = %v, want [%v, %v]", elapsed, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestLimiterWaitCancel(t *testing.T) {
+	l := &Limiter{PrivateRate: Rate{Requests: 1, Per: time.Hour}}
+	waitAll(t, l, "1")
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- l.Wait(ctx, "1")
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for l.Queued() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("request not queued")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Wait() = %v, want context.Canceled", err)
+	}
+	if l.Queued() != 0 {
+		t.Errorf("Queued() = %d after cancel, want 0", l.Queued())
+	}
+	// the cancelled request returns its token, so the next one waits only for the first one
+	if d := l.chats["1"].reserve(time.Now()); d > time.Hour {
+		t.Errorf("next request waits %v, want at most 1h", d)
+	}
+}
+
+func TestIsLimitedMethod(t *testing.T) {
+	tests := map[string]bool{
+		"sendMessage":     true,
+		"sendPhoto":       true,
+		"sendMediaGroup":  true,
+		"forwardMessage":  true,
+		"sendChatAction":  false,
+		"getUpdates":      false,
+		"editMessageText": false,
+	}
+	for method, want := range tests {
+		if got := isLimitedMethod(method); got != want {
+			t.Errorf("isLimitedMethod(%s) = %v, want %v", method, got, want)
+		}
+	}
+}
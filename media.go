@@ -0,0 +1,140 @@
+package telego
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// Number of items in a media group
+const (
+	mediaGroupMinSize = 2
+	mediaGroupMaxSize = 10
+)
+
+// InputMedia - This object represents the content of a media message to be sent: *InputMediaPhoto or
+// *InputMediaVideo
+type InputMedia interface {
+	mediaFile() *InputFile
+	mediaValue(media string) inputMedia
+}
+
+// inputMedia - JSON of InputMedia with media set to a file_id, URL or attach://<name> of an uploaded file
+type inputMedia struct {
+	Type     string `json:"type"`
+	Media    string `json:"media"`
+	Caption  string `json:"caption,omitempty"`
+	Width    int    `json:"width,omitempty"`
+	Height   int    `json:"height,omitempty"`
+	Duration int    `json:"duration,omitempty"`
+}
+
+// InputMediaPhoto - Represents a photo to be sent.
+//
+// media	InputFile	File to send. Pass a file_id to send a file that exists on the Telegram servers
+//						(recommended), pass an HTTP URL for Telegram to get a file from the Internet, or a path
+//						or a reader to upload a new one.
+// caption	String		Optional. Caption of the photo to be sent, 0-200 characters
+type InputMediaPhoto struct {
+	Media   *InputFile
+	Caption string
+}
+
+func (m *InputMediaPhoto) mediaFile() *InputFile {
+	if m == nil {
+		return nil
+	}
+	return m.Media
+}
+
+func (m *InputMediaPhoto) mediaValue(media string) inputMedia {
+	return inputMedia{Type: "photo", Media: media, Caption: m.Caption}
+}
+
+// InputMediaVideo - Represents a video to be sent.
+//
+// media	InputFile	File to send. Pass a file_id to send a file that exists on the Telegram servers
+//						(recommended), pass an HTTP URL for Telegram to get a file from the Internet, or a path
+//						or a reader to upload a new one.
+// caption	String		Optional. Caption of the video to be sent, 0-200 characters
+// width	Integer		Optional. Video width
+// height	Integer		Optional. Video height
+// duration	Integer		Optional. Video duration
+type InputMediaVideo struct {
+	Media    *InputFile
+	Caption  string
+	Width    int
+	Height   int
+	Duration int
+}
+
+func (m *InputMediaVideo) mediaFile() *InputFile {
+	if m == nil {
+		return nil
+	}
+	return m.Media
+}
+
+func (m *InputMediaVideo) mediaValue(media string) inputMedia {
+	return inputMedia{Type: "video", Media: media, Caption: m.Caption, Width: m.Width, Height: m.Height, Duration: m.Duration}
+}
+
+// SendMediaGroup - "sendMediaGroup" Use this method to send a group of photos or videos as an album. On
+// success, an array of the sent Messages is returned. Files to upload are sent in the same multipart request
+// and referenced from media with attach://<file_attach_name>. Returns ErrMissingParam without sending the
+// request if the number of items is not 2-10.
+//
+// chat_id				Integer or	Yes			Unique identifier for the target chat or username of the target
+//						String					channel (in the format @channelusername)
+// media				Array of	Yes			A JSON-serialized array describing photos and videos to be sent,
+//						InputMedia				must include 2–10 items
+// disable_notification	Boolean		Optional	Sends the messages silently. Users will receive a notification
+//												with no sound.
+// reply_to_message_id	Integer		Optional	If the messages are a reply, ID of the original message
+func (bot *Bot) SendMediaGroup(opt *SendMediaGroupOpt) ([]Message, error) {
+	if opt.ChatID == "" || len(opt.Media) < mediaGroupMinSize || len(opt.Media) > mediaGroupMaxSize {
+		return nil, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", opt.ChatID)
+	files := make(map[string]*InputFile)
+	media := make([]inputMedia, len(opt.Media))
+	for i, m := range opt.Media {
+		if m == nil {
+			return nil, ErrMissingParam
+		}
+		file := m.mediaFile()
+		if file.empty() {
+			return nil, ErrMissingParam
+		}
+		if file.isUpload() {
+			name := "media" + strconv.Itoa(i)
+			files[name] = file
+			media[i] = m.mediaValue("attach://" + name)
+		} else {
+			media[i] = m.mediaValue(file.value())
+		}
+	}
+	err := setJSONValue(values, "media", media)
+	if err != nil {
+		errLog("SendMediaGroup setJSONValue", err)
+		return nil, err
+	}
+	if opt.DisableNotification {
+		values.Set("disable_notification", "true")
+	}
+	if opt.ReplyToMessageID > 0 {
+		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
+	}
+	r, err := bot.createFileResponse("sendMediaGroup", values, files)
+	if err != nil {
+		errLog("SendMediaGroup createResponse", err)
+		return nil, err
+	}
+	var messages []Message
+	err = json.Unmarshal(r.Result, &messages)
+	if err != nil {
+		errLog("SendMediaGroup Unmarshal", err)
+	}
+	return messages, err
+}
@@ -0,0 +1,74 @@
+package telego
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSendMediaGroupValidation(t *testing.T) {
+	photo := &InputMediaPhoto{Media: NewInputFileID("AgAD")}
+	album := func(n int) []InputMedia {
+		media := make([]InputMedia, n)
+		for i := range media {
+			media[i] = photo
+		}
+		return media
+	}
+	tests := []struct {
+		name  string
+		media []InputMedia
+	}{
+		{"empty", nil},
+		{"one item", album(1)},
+		{"eleven items", album(11)},
+		{"nil item", []InputMedia{photo, nil}},
+		{"nil photo", []InputMedia{photo, (*InputMediaPhoto)(nil)}},
+		{"nil video", []InputMedia{(*InputMediaVideo)(nil), photo}},
+		{"empty file", []InputMedia{photo, &InputMediaPhoto{}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, values, _ := uploadStub(t, []Message{})
+			_, err := bot.SendMediaGroup(&SendMediaGroupOpt{ChatID: "1", Media: tt.media})
+			if err != ErrMissingParam {
+				t.Errorf("err = %v, want ErrMissingParam", err)
+			}
+			if len(values) != 0 {
+				t.Error("request sent for an invalid album")
+			}
+		})
+	}
+}
+
+func TestSendMediaGroup(t *testing.T) {
+	bot, values, files := uploadStub(t, []Message{{MessageID: 1}, {MessageID: 2}})
+	messages, err := bot.SendMediaGroup(&SendMediaGroupOpt{
+		ChatID: "1",
+		Media: []InputMedia{
+			&InputMediaPhoto{Media: NewInputFileID("AgAD"), Caption: "first"},
+			&InputMediaVideo{Media: NewInputFileReader("b.mp4", strings.NewReader("video"))},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 2 {
+		t.Errorf("got %d messages, want 2", len(messages))
+	}
+	var media []inputMedia
+	err = json.Unmarshal([]byte(values["media"]), &media)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []inputMedia{
+		{Type: "photo", Media: "AgAD", Caption: "first"},
+		{Type: "video", Media: "attach://media1"},
+	}
+	if len(media) != len(want) || media[0] != want[0] || media[1] != want[1] {
+		t.Errorf("media = %+v, want %+v", media, want)
+	}
+	if files["media1"] != (uploadedFile{"b.mp4", "video"}) {
+		t.Errorf("uploaded file = %+v", files["media1"])
+	}
+}
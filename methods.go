@@ -95,9 +95,17 @@ func (bot *Bot) SendMessage(opt *SendMessageOpts) (Message, error) {
 	if opt.ReplyToMessageID > 0 {
 		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
 	}
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendMessage setJSONValue", err)
+			return Message{}, err
+		}
+	}
 	r, err := bot.createResponse("sendMessage", values)
 	if err != nil {
 		errLog("SendMessage createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
@@ -132,6 +140,7 @@ func (bot *Bot) ForwardMessage(opt *ForwardMessageOpts) (Message, error) {
 	r, err := bot.createResponse("forwardMessage", values)
 	if err != nil {
 		errLog("forwardMessage createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
@@ -162,11 +171,12 @@ func (bot *Bot) ForwardMessage(opt *ForwardMessageOpts) (Message, error) {
 //				ForceReply
 func (bot *Bot) SendPhoto(opt *SendPhotoOpts) (Message, error) {
 	values := url.Values{}
-	if opt.ChatID == "" || opt.Photo == "" {
+	if opt.ChatID == "" || opt.Photo.empty() {
 		return Message{}, ErrMissingParam
 	}
 	values.Set("chat_id", opt.ChatID)
-	values.Set("photo", opt.Photo)
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "photo", opt.Photo)
 	if opt.Caption != "" {
 		values.Set("caption", opt.Caption)
 	}
@@ -176,9 +186,17 @@ func (bot *Bot) SendPhoto(opt *SendPhotoOpts) (Message, error) {
 	if opt.ReplyToMessageID > 0 {
 		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
 	}
-	r, err := bot.createResponse("sendPhoto", values)
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendPhoto setJSONValue", err)
+			return Message{}, err
+		}
+	}
+	r, err := bot.createFileResponse("sendPhoto", values, files)
 	if err != nil {
 		errLog("SendPhoto createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
@@ -214,11 +232,12 @@ func (bot *Bot) SendPhoto(opt *SendPhotoOpts) (Message, error) {
 //				or ForceReply
 func (bot *Bot) SendAudio(opt *SendAudioOpt) (Message, error) {
 	values := url.Values{}
-	if opt.ChatID == "" || opt.Audio == "" {
+	if opt.ChatID == "" || opt.Audio.empty() {
 		return Message{}, ErrMissingParam
 	}
 	values.Set("chat_id", opt.ChatID)
-	values.Set("audio", opt.Audio)
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "audio", opt.Audio)
 	if opt.Caption != "" {
 		values.Set("caption", opt.Caption)
 	}
@@ -237,9 +256,17 @@ func (bot *Bot) SendAudio(opt *SendAudioOpt) (Message, error) {
 	if opt.ReplyToMessageID > 0 {
 		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
 	}
-	r, err := bot.createResponse("SendAudio", values)
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendAudio setJSONValue", err)
+			return Message{}, err
+		}
+	}
+	r, err := bot.createFileResponse("sendAudio", values, files)
 	if err != nil {
 		errLog("SendAudio createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
@@ -271,11 +298,12 @@ func (bot *Bot) SendAudio(opt *SendAudioOpt) (Message, error) {
 //				or ForceReply
 func (bot *Bot) SendDocument(opt *SendDocumentOpt) (Message, error) {
 	values := url.Values{}
-	if opt.ChatID == "" || opt.Document == "" {
+	if opt.ChatID == "" || opt.Document.empty() {
 		return Message{}, ErrMissingParam
 	}
 	values.Set("chat_id", opt.ChatID)
-	values.Set("document", opt.Document)
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "document", opt.Document)
 	if opt.Caption != "" {
 		values.Set("caption", opt.Caption)
 	}
@@ -285,9 +313,17 @@ func (bot *Bot) SendDocument(opt *SendDocumentOpt) (Message, error) {
 	if opt.ReplyToMessageID > 0 {
 		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
 	}
-	r, err := bot.createResponse("SendDocument", values)
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendDocument setJSONValue", err)
+			return Message{}, err
+		}
+	}
+	r, err := bot.createFileResponse("sendDocument", values, files)
 	if err != nil {
 		errLog("SendDocument createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
@@ -321,11 +357,12 @@ func (bot *Bot) SendDocument(opt *SendDocumentOpt) (Message, error) {
 //			or ForceReply
 func (bot *Bot) SendVideo(opt *SendVideoOpt) (Message, error) {
 	values := url.Values{}
-	if opt.ChatID == "" || opt.Video == "" {
+	if opt.ChatID == "" || opt.Video.empty() {
 		return Message{}, ErrMissingParam
 	}
 	values.Set("chat_id", opt.ChatID)
-	values.Set("video", opt.Video)
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "video", opt.Video)
 	if opt.Duration > 0 {
 		values.Set("duration", strconv.Itoa(opt.Duration))
 	}
@@ -344,14 +381,22 @@ func (bot *Bot) SendVideo(opt *SendVideoOpt) (Message, error) {
 	if opt.ReplyToMessageID > 0 {
 		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
 	}
-	r, err := bot.createResponse("SendDocument", values)
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendVideo setJSONValue", err)
+			return Message{}, err
+		}
+	}
+	r, err := bot.createFileResponse("sendVideo", values, files)
 	if err != nil {
-		errLog("SendDocument createResponse", err)
+		errLog("SendVideo createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
 	if err != nil {
-		errLog("SendDocument Unmarshal", err)
+		errLog("SendVideo Unmarshal", err)
 	}
 	return message, err
 }
@@ -379,11 +424,12 @@ func (bot *Bot) SendVideo(opt *SendVideoOpt) (Message, error) {
 //			or ForceReply
 func (bot *Bot) SendVoice(opt *SendVoiceOpt) (Message, error) {
 	values := url.Values{}
-	if opt.ChatID == "" || opt.Voice == "" {
+	if opt.ChatID == "" || opt.Voice.empty() {
 		return Message{}, ErrMissingParam
 	}
 	values.Set("chat_id", opt.ChatID)
-	values.Set("voice", opt.Voice)
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "voice", opt.Voice)
 	if opt.Caption != "" {
 		values.Set("caption", opt.Caption)
 	}
@@ -396,14 +442,22 @@ func (bot *Bot) SendVoice(opt *SendVoiceOpt) (Message, error) {
 	if opt.ReplyToMessageID > 0 {
 		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
 	}
-	r, err := bot.createResponse("SendDocument", values)
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendVoice setJSONValue", err)
+			return Message{}, err
+		}
+	}
+	r, err := bot.createFileResponse("sendVoice", values, files)
 	if err != nil {
-		errLog("SendDocument createResponse", err)
+		errLog("SendVoice createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
 	if err != nil {
-		errLog("SendDocument Unmarshal", err)
+		errLog("SendVoice Unmarshal", err)
 	}
 	return message, err
 }
@@ -427,11 +481,12 @@ func (bot *Bot) SendVoice(opt *SendVoiceOpt) (Message, error) {
 //				or ForceReply
 func (bot *Bot) SendVideoNote(opt *SendVideoNoteOpt) (Message, error) {
 	values := url.Values{}
-	if opt.ChatID == "" || opt.VideoNote == "" {
+	if opt.ChatID == "" || opt.VideoNote.empty() {
 		return Message{}, ErrMissingParam
 	}
 	values.Set("chat_id", opt.ChatID)
-	values.Set("video_note", opt.VideoNote)
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "video_note", opt.VideoNote)
 	if opt.Duration > 0 {
 		values.Set("duration", strconv.Itoa(opt.Duration))
 	}
@@ -444,14 +499,22 @@ func (bot *Bot) SendVideoNote(opt *SendVideoNoteOpt) (Message, error) {
 	if opt.ReplyToMessageID > 0 {
 		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
 	}
-	r, err := bot.createResponse("SendDocument", values)
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendVideoNote setJSONValue", err)
+			return Message{}, err
+		}
+	}
+	r, err := bot.createFileResponse("sendVideoNote", values, files)
 	if err != nil {
-		errLog("SendDocument createResponse", err)
+		errLog("SendVideoNote createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
 	if err != nil {
-		errLog("SendDocument Unmarshal", err)
+		errLog("SendVideoNote Unmarshal", err)
 	}
 	return message, err
 }
@@ -462,6 +525,8 @@ func (bot *Bot) SendVideoNote(opt *SendVideoNoteOpt) (Message, error) {
 //											channel (in the format @channelusername)
 // latitude				Float	Yes			Latitude of location
 // longitude			Float	Yes			Longitude of location
+// live_period			Integer	Optional	Period in seconds for which the location will be updated (see Live
+//											Locations), should be between 60 and 86400.
 // disable_notification	Boolean	Optional	Sends the message silently. iOS users will not receive a notification,
 //											Android users will receive a notification with no sound.
 // reply_to_message_id	Integer	Optional	If the message is a reply, ID of the original message
@@ -477,20 +542,31 @@ func (bot *Bot) SendLocation(opt *SendLocationOpt) (Message, error) {
 	values.Set("chat_id", opt.ChatID)
 	values.Set("latitude", strconv.FormatFloat(opt.Latitude, 'f', -1, 64))
 	values.Set("longitude", strconv.FormatFloat(opt.Longitude, 'f', -1, 64))
+	if opt.LivePeriod > 0 {
+		values.Set("live_period", strconv.Itoa(opt.LivePeriod))
+	}
 	if opt.DisableNotification {
 		values.Set("disable_notification", "true")
 	}
 	if opt.ReplyToMessageID > 0 {
 		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
 	}
-	r, err := bot.createResponse("SendDocument", values)
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendLocation setJSONValue", err)
+			return Message{}, err
+		}
+	}
+	r, err := bot.createResponse("sendLocation", values)
 	if err != nil {
-		errLog("SendDocument createResponse", err)
+		errLog("SendLocation createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
 	if err != nil {
-		errLog("SendDocument Unmarshal", err)
+		errLog("SendLocation Unmarshal", err)
 	}
 	return message, err
 }
@@ -513,7 +589,7 @@ func (bot *Bot) SendLocation(opt *SendLocationOpt) (Message, error) {
 //				or ForceReply	Optional
 func (bot *Bot) SendVenue(opt *SendVenueOpt) (Message, error) {
 	values := url.Values{}
-	if opt.ChatID == "" || opt.Latitude == 0 || opt.Longitude == 0 || opt.Title != "" || opt.Address != "" {
+	if opt.ChatID == "" || opt.Latitude == 0 || opt.Longitude == 0 || opt.Title == "" || opt.Address == "" {
 		return Message{}, ErrMissingParam
 	}
 	values.Set("chat_id", opt.ChatID)
@@ -530,14 +606,22 @@ func (bot *Bot) SendVenue(opt *SendVenueOpt) (Message, error) {
 	if opt.ReplyToMessageID > 0 {
 		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
 	}
-	r, err := bot.createResponse("SendDocument", values)
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendVenue setJSONValue", err)
+			return Message{}, err
+		}
+	}
+	r, err := bot.createResponse("sendVenue", values)
 	if err != nil {
-		errLog("SendDocument createResponse", err)
+		errLog("SendVenue createResponse", err)
+		return Message{}, err
 	}
 	var message Message
 	err = json.Unmarshal(r.Result, &message)
 	if err != nil {
-		errLog("SendDocument Unmarshal", err)
+		errLog("SendVenue Unmarshal", err)
 	}
 	return message, err
 }
@@ -558,15 +642,43 @@ func (bot *Bot) SendVenue(opt *SendVenueOpt) (Message, error) {
 //		or ReplyKeyboardRemove
 //		or ForceReply
 
-// SendChatAction - Use this method when you need to tell the user that something is happening on the
-// bot's side. The status is set for 5 seconds or less (when a message arrives from your bot, Telegram
-// clients clear its typing status). Returns True on success.
+// SendChatAction - "sendChatAction" Use this method when you need to tell the user that something is
+// happening on the bot's side. The status is set for 5 seconds or less (when a message arrives from your
+// bot, Telegram clients clear its typing status). Returns True on success. Use KeepChatAction to show the
+// status until a long operation is done.
 //
-// Example: The ImageBot needs some time to process a request and upload the image. Instead of sending a text message along the lines of “Retrieving image, please wait…”, the bot may use sendChatAction with action = upload_photo. The user will see a “sending photo” status for the bot.
-// We only recommend using this method when a response from the bot will take a noticeable amount of time to arrive.
+// Example: The ImageBot needs some time to process a request and upload the image. Instead of sending a
+// text message along the lines of "Retrieving image, please wait...", the bot may use sendChatAction with
+// action = upload_photo. The user will see a "sending photo" status for the bot.
+// We only recommend using this method when a response from the bot will take a noticeable amount of time
+// to arrive.
 //
-// chat_id	Integer or String	Yes	Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-// action	String	Yes	Type of action to broadcast. Choose one, depending on what the user is about to receive: typing for text messages, upload_photo for photos, record_video or upload_video for videos, record_audio or upload_audio for audio files, upload_document for general files, find_location for location data.
+// chat_id	Integer or String	Yes	Unique identifier for the target chat or username of the target channel
+//								(in the format @channelusername)
+// action	String				Yes	Type of action to broadcast. Choose one, depending on what the user is
+//								about to receive: typing for text messages, upload_photo for photos,
+//								record_video or upload_video for videos, record_audio or upload_audio for
+//								audio files, upload_document for general files, find_location for location
+//								data, record_video_note or upload_video_note for video notes.
+func (bot *Bot) SendChatAction(chatID string, action ChatAction) (bool, error) {
+	if chatID == "" || action == "" {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	values.Set("action", string(action))
+	r, err := bot.createResponse("sendChatAction", values)
+	if err != nil {
+		errLog("SendChatAction createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("SendChatAction Unmarshal", err)
+	}
+	return result, err
+}
 
 // getUserProfilePhotos
 // Use this method to get a list of profile pictures for a user. Returns a UserProfilePhotos object.
@@ -575,19 +687,71 @@ func (bot *Bot) SendVenue(opt *SendVenueOpt) (Message, error) {
 // offset	Integer	Optional	Sequential number of the first photo to be returned. By default, all photos are returned.
 // limit	Integer	Optional	Limits the number of photos to be retrieved. Values between 1—100 are accepted. Defaults to 100.
 
-// getFile
-// Use this method to get basic info about a file and prepare it for downloading. For the moment, bots can download files of up to 20MB in size. On success, a File object is returned. The file can then be downloaded via the link https://api.telegram.org/file/bot<token>/<file_path>, where <file_path> is taken from the response. It is guaranteed that the link will be valid for at least 1 hour. When the link expires, a new one can be requested by calling getFile again.
+// GetFile - "getFile" Use this method to get basic info about a file and prepare it for downloading. For
+// the moment, bots can download files of up to 20MB in size. On success, a File object is returned. The
+// file can then be downloaded with DownloadFile. It is guaranteed that the link will be valid for at
+// least 1 hour. When the link expires, a new one can be requested by calling getFile again.
 //
 // file_id	String	Yes	File identifier to get info about
-// Note: This function may not preserve the original file name and MIME type. You should save the file's MIME type and name (if available) when the File object is received.
+//
+// Note: This function may not preserve the original file name and MIME type. You should save the file's
+// MIME type and name (if available) when the File object is received.
+func (bot *Bot) GetFile(fileID string) (File, error) {
+	if fileID == "" {
+		return File{}, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("file_id", fileID)
+	r, err := bot.createResponse("getFile", values)
+	if err != nil {
+		errLog("GetFile createResponse", err)
+		return File{}, err
+	}
+	var file File
+	err = json.Unmarshal(r.Result, &file)
+	if err != nil {
+		errLog("GetFile Unmarshal", err)
+	}
+	return file, err
+}
 
-// kickChatMember
-// Use this method to kick a user from a group or a supergroup. In the case of supergroups, the user will not be able to return to the group on their own using invite links, etc., unless unbanned first. The bot must be an administrator in the group for this to work. Returns True on success.
+// KickChatMember - "kickChatMember" Use this method to kick a user from a group, a supergroup or a channel.
+// In the case of supergroups and channels, the user will not be able to return to the group on their own
+// using invite links, etc., unless unbanned first. The bot must be an administrator in the chat for this
+// to work and must have the appropriate admin rights. Returns True on success.
 //
-// Note: This will method only work if the ‘All Members Are Admins’ setting is off in the target group. Otherwise members may only be removed by the group's creator or by the member that added them.
+// Note: In regular groups (non-supergroups), this method will only work if the ‘All Members Are Admins’
+// setting is off in the target group. Otherwise members may only be removed by the group's creator or
+// by the member that added them.
 //
-// chat_id	Integer or String	Yes	Unique identifier for the target group or username of the target supergroup (in the format @supergroupusername)
-// user_id	Integer	Yes	Unique identifier of the target user
+// chat_id		Integer or	Yes			Unique identifier for the target group or username of the target
+//				String					supergroup or channel (in the format @channelusername)
+// user_id		Integer		Yes			Unique identifier of the target user
+// until_date	Integer		Optional	Date when the user will be unbanned, unix time. If user is banned
+//										for more than 366 days or less than 30 seconds from the current
+//										time they are considered to be banned forever
+func (bot *Bot) KickChatMember(opt *KickChatMemberOpt) (bool, error) {
+	if opt.ChatID == "" || opt.UserID == 0 {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", opt.ChatID)
+	values.Set("user_id", strconv.Itoa(opt.UserID))
+	if opt.UntilDate > 0 {
+		values.Set("until_date", strconv.FormatInt(opt.UntilDate, 10))
+	}
+	r, err := bot.createResponse("kickChatMember", values)
+	if err != nil {
+		errLog("KickChatMember createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("KickChatMember Unmarshal", err)
+	}
+	return result, err
+}
 
 // LeaveChat - "leaveChat" Use this method for your bot to leave a group, supergroup or channel. Returns True on success.
 //
@@ -599,6 +763,7 @@ func (bot *Bot) LeaveChat(chatID string) (bool, error) {
 	r, err := bot.createResponse("leaveChat", values)
 	if err != nil {
 		errLog("LeaveChat createResponse", err)
+		return false, err
 	}
 	var result bool
 	err = json.Unmarshal(r.Result, &result)
@@ -608,42 +773,474 @@ func (bot *Bot) LeaveChat(chatID string) (bool, error) {
 	return result, err
 }
 
-// unbanChatMember
-// Use this method to unban a previously kicked user in a supergroup. The user will not return to the group automatically, but will be able to join via link, etc. The bot must be an administrator in the group for this to work. Returns True on success.
+// UnbanChatMember - "unbanChatMember" Use this method to unban a previously kicked user in a supergroup or
+// channel. The user will not return to the group or channel automatically, but will be able to join via
+// link, etc. The bot must be an administrator for this to work. Returns True on success.
 //
-// chat_id	Integer or String	Yes	Unique identifier for the target group or username of the target supergroup (in the format @supergroupusername)
-// user_id	Integer	Yes	Unique identifier of the target user
+// chat_id		Integer or	Yes		Unique identifier for the target group or username of the target
+//				String				supergroup or channel (in the format @username)
+// user_id		Integer		Yes		Unique identifier of the target user
+func (bot *Bot) UnbanChatMember(chatID string, userID int) (bool, error) {
+	if chatID == "" || userID == 0 {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	values.Set("user_id", strconv.Itoa(userID))
+	r, err := bot.createResponse("unbanChatMember", values)
+	if err != nil {
+		errLog("UnbanChatMember createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("UnbanChatMember Unmarshal", err)
+	}
+	return result, err
+}
 
-// getChat
-// Use this method to get up to date information about the chat (current name of the user for one-on-one conversations, current username of a user, group or channel, etc.). Returns a Chat object on success.
+// RestrictChatMember - "restrictChatMember" Use this method to restrict a user in a supergroup. The bot
+// must be an administrator in the supergroup for this to work and must have the appropriate admin rights.
+// Pass True for all boolean parameters to lift restrictions from a user. Returns True on success.
 //
-// chat_id	Integer or String	Yes	Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+// chat_id					Integer or	Yes			Unique identifier for the target chat or username of the
+//							String					target supergroup (in the format @supergroupusername)
+// user_id					Integer		Yes			Unique identifier of the target user
+// until_date				Integer		Optional	Date when restrictions will be lifted for the user, unix
+//													time. If user is restricted for more than 366 days or
+//													less than 30 seconds from the current time, they are
+//													considered to be restricted forever
+// can_send_messages		Boolean		Optional	Pass True, if the user can send text messages, contacts,
+//													locations and venues
+// can_send_media_messages	Boolean		Optional	Pass True, if the user can send audios, documents, photos,
+//													videos, video notes and voice notes, implies
+//													can_send_messages
+// can_send_other_messages	Boolean		Optional	Pass True, if the user can send animations, games,
+//													stickers and use inline bots, implies
+//													can_send_media_messages
+// can_add_web_page_previews
+//							Boolean		Optional	Pass True, if the user may add web page previews to their
+//													messages, implies can_send_media_messages
+func (bot *Bot) RestrictChatMember(opt *RestrictChatMemberOpt) (bool, error) {
+	if opt.ChatID == "" || opt.UserID == 0 {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", opt.ChatID)
+	values.Set("user_id", strconv.Itoa(opt.UserID))
+	if opt.UntilDate > 0 {
+		values.Set("until_date", strconv.FormatInt(opt.UntilDate, 10))
+	}
+	values.Set("can_send_messages", strconv.FormatBool(opt.CanSendMessages))
+	values.Set("can_send_media_messages", strconv.FormatBool(opt.CanSendMediaMessages))
+	values.Set("can_send_other_messages", strconv.FormatBool(opt.CanSendOtherMessages))
+	values.Set("can_add_web_page_previews", strconv.FormatBool(opt.CanAddWebPagePreviews))
+	r, err := bot.createResponse("restrictChatMember", values)
+	if err != nil {
+		errLog("RestrictChatMember createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("RestrictChatMember Unmarshal", err)
+	}
+	return result, err
+}
 
-// getChatAdministrators
-// Use this method to get a list of administrators in a chat. On success, returns an Array of ChatMember objects that contains information about all chat administrators except other bots. If the chat is a group or a supergroup and no administrators were appointed, only the creator will be returned.
+// PromoteChatMember - "promoteChatMember" Use this method to promote or demote a user in a supergroup or a
+// channel. The bot must be an administrator in the chat for this to work and must have the appropriate
+// admin rights. Pass False for all boolean parameters to demote a user. Returns True on success.
 //
-// chat_id	Integer or String	Yes	Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+// chat_id				Integer or	Yes			Unique identifier for the target chat or username of the target
+//						String					channel (in the format @channelusername)
+// user_id				Integer		Yes			Unique identifier of the target user
+// can_change_info		Boolean		Optional	Pass True, if the administrator can change chat title, photo
+//												and other settings
+// can_post_messages	Boolean		Optional	Pass True, if the administrator can create channel posts,
+//												channels only
+// can_edit_messages	Boolean		Optional	Pass True, if the administrator can edit messages of other
+//												users and can pin messages, channels only
+// can_delete_messages	Boolean		Optional	Pass True, if the administrator can delete messages of other users
+// can_invite_users		Boolean		Optional	Pass True, if the administrator can invite new users to the chat
+// can_restrict_members	Boolean		Optional	Pass True, if the administrator can restrict, ban or unban
+//												chat members
+// can_pin_messages		Boolean		Optional	Pass True, if the administrator can pin messages, supergroups only
+// can_promote_members	Boolean		Optional	Pass True, if the administrator can add new administrators with
+//												a subset of their own privileges or demote administrators that
+//												they have promoted, directly or indirectly
+func (bot *Bot) PromoteChatMember(opt *PromoteChatMemberOpt) (bool, error) {
+	if opt.ChatID == "" || opt.UserID == 0 {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", opt.ChatID)
+	values.Set("user_id", strconv.Itoa(opt.UserID))
+	values.Set("can_change_info", strconv.FormatBool(opt.CanChangeInfo))
+	values.Set("can_post_messages", strconv.FormatBool(opt.CanPostMessages))
+	values.Set("can_edit_messages", strconv.FormatBool(opt.CanEditMessages))
+	values.Set("can_delete_messages", strconv.FormatBool(opt.CanDeleteMessages))
+	values.Set("can_invite_users", strconv.FormatBool(opt.CanInviteUsers))
+	values.Set("can_restrict_members", strconv.FormatBool(opt.CanRestrictMembers))
+	values.Set("can_pin_messages", strconv.FormatBool(opt.CanPinMessages))
+	values.Set("can_promote_members", strconv.FormatBool(opt.CanPromoteMembers))
+	r, err := bot.createResponse("promoteChatMember", values)
+	if err != nil {
+		errLog("PromoteChatMember createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("PromoteChatMember Unmarshal", err)
+	}
+	return result, err
+}
 
-// getChatMembersCount
-// Use this method to get the number of members in a chat. Returns Int on success.
+// ExportChatInviteLink - "exportChatInviteLink" Use this method to export an invite link to a supergroup
+// or a channel. The bot must be an administrator in the chat for this to work and must have the
+// appropriate admin rights. Returns exported invite link as String on success.
 //
-// chat_id	Integer or String	Yes	Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+// chat_id		Integer or	Yes		Unique identifier for the target chat or username of the target
+//				String				channel (in the format @channelusername)
+func (bot *Bot) ExportChatInviteLink(chatID string) (string, error) {
+	if chatID == "" {
+		return "", ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	r, err := bot.createResponse("exportChatInviteLink", values)
+	if err != nil {
+		errLog("ExportChatInviteLink createResponse", err)
+		return "", err
+	}
+	var link string
+	err = json.Unmarshal(r.Result, &link)
+	if err != nil {
+		errLog("ExportChatInviteLink Unmarshal", err)
+	}
+	return link, err
+}
 
-// getChatMember
-// Use this method to get information about a member of a chat. Returns a ChatMember object on success.
+// SetChatPhoto - "setChatPhoto" Use this method to set a new profile photo for the chat. Photos can't be
+// changed for private chats. The bot must be an administrator in the chat for this to work and must
+// have the appropriate admin rights. Returns True on success.
 //
-// chat_id	Integer or String	Yes	Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
-// user_id	Integer	Yes	Unique identifier of the target user
+// Note: In regular groups (non-supergroups), this method will only work if the ‘All Members Are Admins’
+// setting is off in the target group.
+//
+// chat_id		Integer or	Yes		Unique identifier for the target chat or username of the target
+//				String				channel (in the format @channelusername)
+// photo		InputFile	Yes		New chat photo, uploaded using multipart/form-data
+func (bot *Bot) SetChatPhoto(chatID string, photo *InputFile) (bool, error) {
+	if chatID == "" || photo.empty() || !photo.isUpload() {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "photo", photo)
+	r, err := bot.createFileResponse("setChatPhoto", values, files)
+	if err != nil {
+		errLog("SetChatPhoto createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("SetChatPhoto Unmarshal", err)
+	}
+	return result, err
+}
 
-// answerCallbackQuery
-// Use this method to send answers to callback queries sent from inline keyboards. The answer will be displayed to the user as a notification at the top of the chat screen or as an alert. On success, True is returned.
+// DeleteChatPhoto - "deleteChatPhoto" Use this method to delete a chat photo. Photos can't be changed for
+// private chats. The bot must be an administrator in the chat for this to work and must have the
+// appropriate admin rights. Returns True on success.
 //
-// Alternatively, the user can be redirected to the specified Game URL. For this option to work, you must first create a game for your bot via BotFather and accept the terms. Otherwise, you may use links like telegram.me/your_bot?start=XXXX that open your bot with a parameter.
+// chat_id		Integer or	Yes		Unique identifier for the target chat or username of the target
+//				String				channel (in the format @channelusername)
+func (bot *Bot) DeleteChatPhoto(chatID string) (bool, error) {
+	if chatID == "" {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	r, err := bot.createResponse("deleteChatPhoto", values)
+	if err != nil {
+		errLog("DeleteChatPhoto createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("DeleteChatPhoto Unmarshal", err)
+	}
+	return result, err
+}
+
+// SetChatTitle - "setChatTitle" Use this method to change the title of a chat. Titles can't be changed
+// for private chats. The bot must be an administrator in the chat for this to work and must have the
+// appropriate admin rights. Returns True on success.
+//
+// chat_id		Integer or	Yes		Unique identifier for the target chat or username of the target
+//				String				channel (in the format @channelusername)
+// title		String		Yes		New chat title, 1-255 characters
+func (bot *Bot) SetChatTitle(chatID, title string) (bool, error) {
+	if chatID == "" || title == "" {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	values.Set("title", title)
+	r, err := bot.createResponse("setChatTitle", values)
+	if err != nil {
+		errLog("SetChatTitle createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("SetChatTitle Unmarshal", err)
+	}
+	return result, err
+}
+
+// SetChatDescription - "setChatDescription" Use this method to change the description of a supergroup or
+// a channel. The bot must be an administrator in the chat for this to work and must have the
+// appropriate admin rights. Returns True on success.
+//
+// chat_id		Integer or	Yes			Unique identifier for the target chat or username of the target
+//				String					channel (in the format @channelusername)
+// description	String		Optional	New chat description, 0-255 characters
+func (bot *Bot) SetChatDescription(chatID, description string) (bool, error) {
+	if chatID == "" {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	values.Set("description", description)
+	r, err := bot.createResponse("setChatDescription", values)
+	if err != nil {
+		errLog("SetChatDescription createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("SetChatDescription Unmarshal", err)
+	}
+	return result, err
+}
+
+// PinChatMessage - "pinChatMessage" Use this method to pin a message in a supergroup or a channel. The bot
+// must be an administrator in the chat for this to work and must have the ‘can_pin_messages’ admin right
+// in the supergroup or ‘can_edit_messages’ admin right in the channel. Returns True on success.
+//
+// chat_id				Integer or	Yes			Unique identifier for the target chat or username of the
+//						String					target channel (in the format @channelusername)
+// message_id			Integer		Yes			Identifier of a message to pin
+// disable_notification	Boolean		Optional	Pass True, if it is not necessary to send a notification
+//												to all chat members about the new pinned message.
+//												Notifications are always disabled in channels.
+func (bot *Bot) PinChatMessage(opt *PinChatMessageOpt) (bool, error) {
+	if opt.ChatID == "" || opt.MessageID == 0 {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", opt.ChatID)
+	values.Set("message_id", strconv.Itoa(opt.MessageID))
+	if opt.DisableNotification {
+		values.Set("disable_notification", "true")
+	}
+	r, err := bot.createResponse("pinChatMessage", values)
+	if err != nil {
+		errLog("PinChatMessage createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("PinChatMessage Unmarshal", err)
+	}
+	return result, err
+}
+
+// UnpinChatMessage - "unpinChatMessage" Use this method to unpin a message in a supergroup or a channel.
+// The bot must be an administrator in the chat for this to work and must have the ‘can_pin_messages’
+// admin right in the supergroup or ‘can_edit_messages’ admin right in the channel. Returns True on
+// success.
+//
+// chat_id		Integer or	Yes		Unique identifier for the target chat or username of the target
+//				String				channel (in the format @channelusername)
+func (bot *Bot) UnpinChatMessage(chatID string) (bool, error) {
+	if chatID == "" {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	r, err := bot.createResponse("unpinChatMessage", values)
+	if err != nil {
+		errLog("UnpinChatMessage createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("UnpinChatMessage Unmarshal", err)
+	}
+	return result, err
+}
+
+// GetChat - "getChat" Use this method to get up to date information about the chat (current name of the
+// user for one-on-one conversations, current username of a user, group or channel, etc.). Returns a
+// Chat object on success.
+//
+// chat_id		Integer or	Yes		Unique identifier for the target chat or username of the target
+//				String				supergroup or channel (in the format @channelusername)
+func (bot *Bot) GetChat(chatID string) (Chat, error) {
+	if chatID == "" {
+		return Chat{}, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	r, err := bot.createResponse("getChat", values)
+	if err != nil {
+		errLog("GetChat createResponse", err)
+		return Chat{}, err
+	}
+	var chat Chat
+	err = json.Unmarshal(r.Result, &chat)
+	if err != nil {
+		errLog("GetChat Unmarshal", err)
+	}
+	return chat, err
+}
+
+// GetChatAdministrators - "getChatAdministrators" Use this method to get a list of administrators in a
+// chat. On success, returns an Array of ChatMember objects that contains information about all chat
+// administrators except other bots. If the chat is a group or a supergroup and no administrators were
+// appointed, only the creator will be returned.
+//
+// chat_id		Integer or	Yes		Unique identifier for the target chat or username of the target
+//				String				supergroup or channel (in the format @channelusername)
+func (bot *Bot) GetChatAdministrators(chatID string) ([]ChatMember, error) {
+	if chatID == "" {
+		return nil, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	r, err := bot.createResponse("getChatAdministrators", values)
+	if err != nil {
+		errLog("GetChatAdministrators createResponse", err)
+		return nil, err
+	}
+	var members []ChatMember
+	err = json.Unmarshal(r.Result, &members)
+	if err != nil {
+		errLog("GetChatAdministrators Unmarshal", err)
+	}
+	return members, err
+}
+
+// GetChatMembersCount - "getChatMembersCount" Use this method to get the number of members in a chat.
+// Returns Int on success.
+//
+// chat_id		Integer or	Yes		Unique identifier for the target chat or username of the target
+//				String				supergroup or channel (in the format @channelusername)
+func (bot *Bot) GetChatMembersCount(chatID string) (int, error) {
+	if chatID == "" {
+		return 0, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	r, err := bot.createResponse("getChatMembersCount", values)
+	if err != nil {
+		errLog("GetChatMembersCount createResponse", err)
+		return 0, err
+	}
+	var count int
+	err = json.Unmarshal(r.Result, &count)
+	if err != nil {
+		errLog("GetChatMembersCount Unmarshal", err)
+	}
+	return count, err
+}
+
+// GetChatMember - "getChatMember" Use this method to get information about a member of a chat. Returns a
+// ChatMember object on success.
 //
-// callback_query_id	String	Yes	Unique identifier for the query to be answered
-// text	String	Optional	Text of the notification. If not specified, nothing will be shown to the user, 0-200 characters
-// show_alert	Boolean	Optional	If true, an alert will be shown by the client instead of a notification at the top of the chat screen. Defaults to false.
-// url	String	Optional	URL that will be opened by the user's client. If you have created a Game and accepted the conditions via @Botfather, specify the URL that opens your game – note that this will only work if the query comes from a callback_game button.
+// chat_id		Integer or	Yes		Unique identifier for the target chat or username of the target
+//				String				supergroup or channel (in the format @channelusername)
+// user_id		Integer		Yes		Unique identifier of the target user
+func (bot *Bot) GetChatMember(chatID string, userID int) (ChatMember, error) {
+	if chatID == "" || userID == 0 {
+		return ChatMember{}, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	values.Set("user_id", strconv.Itoa(userID))
+	r, err := bot.createResponse("getChatMember", values)
+	if err != nil {
+		errLog("GetChatMember createResponse", err)
+		return ChatMember{}, err
+	}
+	var member ChatMember
+	err = json.Unmarshal(r.Result, &member)
+	if err != nil {
+		errLog("GetChatMember Unmarshal", err)
+	}
+	return member, err
+}
 
-// Otherwise, you may use links like telegram.me/your_bot?start=XXXX that open your bot with a parameter.
-// cache_time	Integer	Optional	The maximum amount of time in seconds that the result of the callback query may be cached client-side. Telegram apps will support caching starting in version 3.14. Defaults to 0.
+// AnswerCallbackQuery - "answerCallbackQuery" Use this method to send answers to callback queries sent from
+// inline keyboards. The answer will be displayed to the user as a notification at the top of the chat
+// screen or as an alert. On success, True is returned.
+//
+// Alternatively, the user can be redirected to the specified Game URL. For this option to work, you must
+// first create a game for your bot via BotFather and accept the terms. Otherwise, you may use links like
+// telegram.me/your_bot?start=XXXX that open your bot with a parameter.
+//
+// callback_query_id	String	Yes			Unique identifier for the query to be answered
+// text					String	Optional	Text of the notification. If not specified, nothing will be shown
+//											to the user, 0-200 characters
+// show_alert			Boolean	Optional	If true, an alert will be shown by the client instead of a
+//											notification at the top of the chat screen. Defaults to false.
+// url					String	Optional	URL that will be opened by the user's client. If you have created a
+//											Game and accepted the conditions via @Botfather, specify the URL
+//											that opens your game – note that this will only work if the query
+//											comes from a callback_game button.
+//											Otherwise, you may use links like telegram.me/your_bot?start=XXXX
+//											that open your bot with a parameter.
+// cache_time			Integer	Optional	The maximum amount of time in seconds that the result of the
+//											callback query may be cached client-side. Defaults to 0.
+func (bot *Bot) AnswerCallbackQuery(opt *AnswerCallbackQueryOpt) (bool, error) {
+	if opt.CallbackQueryID == "" {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("callback_query_id", opt.CallbackQueryID)
+	if opt.Text != "" {
+		values.Set("text", opt.Text)
+	}
+	if opt.ShowAlert {
+		values.Set("show_alert", "true")
+	}
+	if opt.URL != "" {
+		values.Set("url", opt.URL)
+	}
+	if opt.CacheTime > 0 {
+		values.Set("cache_time", strconv.Itoa(opt.CacheTime))
+	}
+	r, err := bot.createResponse("answerCallbackQuery", values)
+	if err != nil {
+		errLog("AnswerCallbackQuery createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("AnswerCallbackQuery Unmarshal", err)
+	}
+	return result, err
+}
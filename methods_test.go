@@ -0,0 +1,107 @@
+package telego
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSendMessageReplyMarkup(t *testing.T) {
+	tests := []struct {
+		name   string
+		markup ReplyMarkup
+		want   string
+	}{
+		{"none", nil, ""},
+		{"inline keyboard", InlineKeyboardMarkup{InlineKeyboard: [][]*InlineKeyboardButton{
+			{{Text: "Yes", CallbackData: "yes"}, {Text: "Site", URL: "https://example.com"}},
+		}}, `{"inline_keyboard":[[{"text":"Yes","callback_data":"yes"},{"text":"Site","url":"https://example.com"}]]}`},
+		{"reply keyboard", ReplyKeyboardMarkup{Keyboard: [][]*KeyboardButton{
+			{{Text: "Share", RequestContact: true}},
+		}, ResizeKeyboard: true}, `{"keyboard":[[{"text":"Share","request_contact":true}]],"resize_keyboard":true}`},
+		{"remove keyboard", ReplyKeyboardRemove{RemoveKeyboard: true}, `{"remove_keyboard":true}`},
+		{"force reply", ForceReply{ForceReply: true, Selective: true}, `{"force_reply":true,"selective":true}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var form map[string][]string
+			bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+				r.ParseForm()
+				form = r.PostForm
+				writeResult(w, Message{MessageID: 1})
+			})
+			_, err := bot.SendMessage(&SendMessageOpts{ChatID: "1", Text: "hi", ReplyMarkup: tt.markup})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := form["reply_markup"]
+			if tt.want == "" {
+				if ok {
+					t.Errorf("reply_markup = %q, want none", got)
+				}
+				return
+			}
+			if len(got) != 1 || got[0] != tt.want {
+				t.Errorf("reply_markup = %q, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestrictChatMember(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  RestrictChatMemberOpt
+		want map[string]string
+	}{
+		{"restrict everything", RestrictChatMemberOpt{ChatID: "-100", UserID: 7}, map[string]string{
+			"can_send_messages": "false", "can_send_media_messages": "false",
+			"can_send_other_messages": "false", "can_add_web_page_previews": "false", "until_date": "",
+		}},
+		{"text only until a date", RestrictChatMemberOpt{ChatID: "-100", UserID: 7, UntilDate: 1500000000,
+			CanSendMessages: true}, map[string]string{
+			"can_send_messages": "true", "can_send_media_messages": "false",
+			"can_send_other_messages": "false", "can_add_web_page_previews": "false", "until_date": "1500000000",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, forms := formStub(t, true)
+			ok, err := bot.RestrictChatMember(&tt.opt)
+			if !ok || err != nil {
+				t.Fatalf("RestrictChatMember() = %v, %v", ok, err)
+			}
+			form := (*forms)[0]
+			if form.Get("method") != "restrictChatMember" || form.Get("chat_id") != "-100" || form.Get("user_id") != "7" {
+				t.Errorf("form = %v", form)
+			}
+			for key, want := range tt.want {
+				if got := form.Get(key); got != want {
+					t.Errorf("%s = %q, want %q", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPromoteChatMember(t *testing.T) {
+	bot, forms := formStub(t, true)
+	ok, err := bot.PromoteChatMember(&PromoteChatMemberOpt{ChatID: "-100", UserID: 7, CanDeleteMessages: true,
+		CanPinMessages: true})
+	if !ok || err != nil {
+		t.Fatalf("PromoteChatMember() = %v, %v", ok, err)
+	}
+	want := map[string]string{
+		"method": "promoteChatMember", "can_change_info": "false", "can_post_messages": "false",
+		"can_edit_messages": "false", "can_delete_messages": "true", "can_invite_users": "false",
+		"can_restrict_members": "false", "can_pin_messages": "true", "can_promote_members": "false",
+	}
+	form := (*forms)[0]
+	for key, value := range want {
+		if got := form.Get(key); got != value {
+			t.Errorf("%s = %q, want %q", key, got, value)
+		}
+	}
+	if _, err := bot.PromoteChatMember(&PromoteChatMemberOpt{ChatID: "-100"}); err != ErrMissingParam {
+		t.Errorf("err = %v without user, want ErrMissingParam", err)
+	}
+}
@@ -0,0 +1,77 @@
+package telego
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+)
+
+// Middleware - function that wraps an UpdateHandler to add behavior around it, like logging,
+// authorization or metrics
+type Middleware func(next UpdateHandler) UpdateHandler
+
+// Chain - wrap handler with middlewares. The first middleware is the outermost one, so it is called
+// first and returns last.
+func Chain(handler UpdateHandler, middlewares ...Middleware) UpdateHandler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
+// Recover - middleware that recovers from a panic in the wrapped handler. onPanic is called with the
+// update and the recovered value, if onPanic is nil the panic and stack trace are written to the
+// error log.
+func Recover(onPanic func(update Update, recovered interface{})) Middleware {
+	return func(next UpdateHandler) UpdateHandler {
+		return func(ctx context.Context, update Update) {
+			defer func() {
+				recovered := recover()
+				if recovered == nil {
+					return
+				}
+				if onPanic != nil {
+					onPanic(update, recovered)
+					return
+				}
+				errLog("Recover", fmt.Errorf("panic in update %d: %v\n%s", update.UpdateID, recovered, debug.Stack()))
+			}()
+			next(ctx, update)
+		}
+	}
+}
+
+// Timing - middleware that calls report with the time spent in the wrapped handler
+func Timing(report func(update Update, elapsed time.Duration)) Middleware {
+	return func(next UpdateHandler) UpdateHandler {
+		return func(ctx context.Context, update Update) {
+			start := time.Now()
+			defer func() {
+				report(update, time.Since(start))
+			}()
+			next(ctx, update)
+		}
+	}
+}
+
+// ChatMigrations - middleware that calls bot.OnChatMigrated for service messages about a group migrated
+// to a supergroup, so migrations are reported even if the bot sends nothing to the group. Telegram sends
+// such messages both to the group and to the supergroup, so OnChatMigrated can be called more than once
+// for the same migration. All updates are passed to the next handler.
+func (bot *Bot) ChatMigrations() Middleware {
+	return func(next UpdateHandler) UpdateHandler {
+		return func(ctx context.Context, update Update) {
+			message := update.Message
+			if message != nil && message.Chat != nil && bot.OnChatMigrated != nil {
+				switch {
+				case message.MigrateToChatID != 0:
+					bot.OnChatMigrated(message.Chat.ID, message.MigrateToChatID)
+				case message.MigrateFromChatID != 0:
+					bot.OnChatMigrated(message.MigrateFromChatID, message.Chat.ID)
+				}
+			}
+			next(ctx, update)
+		}
+	}
+}
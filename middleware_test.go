@@ -0,0 +1,99 @@
+package telego
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestChain(t *testing.T) {
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next UpdateHandler) UpdateHandler {
+			return func(ctx context.Context, update Update) {
+				calls = append(calls, name+" before")
+				next(ctx, update)
+				calls = append(calls, name+" after")
+			}
+		}
+	}
+	handler := func(ctx context.Context, update Update) { calls = append(calls, "handler") }
+	tests := []struct {
+		name        string
+		middlewares []Middleware
+		want        []string
+	}{
+		{"no middlewares", nil, []string{"handler"}},
+		{"one", []Middleware{trace("a")}, []string{"a before", "handler", "a after"}},
+		{"first is outermost", []Middleware{trace("a"), trace("b")},
+			[]string{"a before", "b before", "handler", "b after", "a after"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = nil
+			Chain(handler, tt.middlewares...)(context.Background(), Update{})
+			if len(calls) != len(tt.want) {
+				t.Fatalf("calls = %q, want %q", calls, tt.want)
+			}
+			for i := range calls {
+				if calls[i] != tt.want[i] {
+					t.Fatalf("calls = %q, want %q", calls, tt.want)
+				}
+			}
+		})
+	}
+
+	calls = nil
+	r := NewRouter()
+	r.Use(trace("a"))
+	r.Use(trace("b"))
+	r.OnMessage(func(ctx context.Context, message *Message) { calls = append(calls, "message") })
+	r.HandleUpdate(context.Background(), Update{Message: &Message{}})
+	if len(calls) != 5 || calls[0] != "a before" || calls[1] != "b before" || calls[2] != "message" {
+		t.Errorf("router calls = %q", calls)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	var recovered []interface{}
+	onPanic := func(update Update, value interface{}) {
+		if update.UpdateID != 7 {
+			t.Errorf("update %d, want 7", update.UpdateID)
+		}
+		recovered = append(recovered, value)
+	}
+	handler := Chain(func(ctx context.Context, update Update) { panic("handler failed") }, Recover(onPanic))
+	handler(context.Background(), Update{UpdateID: 7})
+	if len(recovered) != 1 || recovered[0] != "handler failed" {
+		t.Errorf("recovered = %v", recovered)
+	}
+
+	calls := 0
+	Chain(func(ctx context.Context, update Update) { calls++ }, Recover(onPanic))(context.Background(), Update{UpdateID: 7})
+	if calls != 1 || len(recovered) != 1 {
+		t.Errorf("%d calls and %d recovered panics without a panic", calls, len(recovered))
+	}
+
+	// without onPanic the panic is logged
+	Chain(func(ctx context.Context, update Update) { panic("handler failed") }, Recover(nil))(context.Background(), Update{})
+}
+
+func TestTiming(t *testing.T) {
+	var reports []time.Duration
+	report := func(update Update, elapsed time.Duration) { reports = append(reports, elapsed) }
+	handler := Chain(func(ctx context.Context, update Update) { time.Sleep(10 * time.Millisecond) },
+		Timing(report))
+	handler(context.Background(), Update{})
+	if len(reports) != 1 || reports[0] < 10*time.Millisecond {
+		t.Errorf("reports = %v, want one over 10ms", reports)
+	}
+
+	// the time is reported even if the handler panics
+	func() {
+		defer func() { recover() }()
+		Chain(func(ctx context.Context, update Update) { panic("handler failed") }, Timing(report))(context.Background(), Update{})
+	}()
+	if len(reports) != 2 {
+		t.Errorf("%d reports after a panic, want 2", len(reports))
+	}
+}
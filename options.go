@@ -2,18 +2,13 @@ package telego
 
 // SendMessageOpts - options for SendMessage
 type SendMessageOpts struct {
-	ChatID                string `json:"chat_id"`
-	Text                  string `json:"text"`
-	ParseMode             string `json:"parse_mode,omitempty"`
-	DisableWebPagePreview bool   `json:"disable_web_page_preview,omitempty"`
-	DisableNotification   bool   `json:"disable_notification,omitempty"`
-	ReplyToMessageID      int    `json:"reply_to_message_id,omitempty"`
-	// reply_markup	            Optional		Additional interface options. A JSON-serialized object for an inline
-	//											keyboard, custom reply keyboard, instructions
-	//              InlineKeyboardMarkup or     to remove reply keyboard or to force a reply from the user.
-	//              ReplyKeyboardMarkup or
-	//              ReplyKeyboardRemove or
-	//              ForceReply
+	ChatID                string      `json:"chat_id"`
+	Text                  string      `json:"text"`
+	ParseMode             string      `json:"parse_mode,omitempty"`
+	DisableWebPagePreview bool        `json:"disable_web_page_preview,omitempty"`
+	DisableNotification   bool        `json:"disable_notification,omitempty"`
+	ReplyToMessageID      int         `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup           ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
 // ForwardMessageOpts - options forForwardMessage
@@ -26,16 +21,12 @@ type ForwardMessageOpts struct {
 
 // SendPhotoOpts - options for SendPhoto
 type SendPhotoOpts struct {
-	ChatID              string `json:"chat_id"`
-	Photo               string `json:"photo"`
-	Caption             string `json:"caption,omitempty"`
-	DisableNotification bool   `json:"disable_notification,omitempty"`
-	ReplyToMessageID    int    `json:"reply_to_message_id,omitempty"`
-	// reply_markup				Optional	Additional interface options. A JSON-serialized object for an inline
-	//			InlineKeyboardMarkup or 	keyboard, custom reply keyboard, instructions to remove reply keyboard
-	//				ReplyKeyboardMarkup or  or to force a reply from the user.
-	//				ReplyKeyboardRemove or
-	//				ForceReply
+	ChatID              string      `json:"chat_id"`
+	Photo               *InputFile  `json:"photo"`
+	Caption             string      `json:"caption,omitempty"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
 // GetUpdatesOpt - options for GetUpdates
@@ -48,10 +39,11 @@ type GetUpdatesOpt struct {
 
 // SetWebhookOpt - options for SetWebhook
 type SetWebhookOpt struct {
-	URL            string   `json:"url"`
-	Certificate    string   `json:"certificate,omitempty"`
-	MaxConnections int      `json:"max_connections,omitempty"`
-	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+	URL            string     `json:"url"`
+	Certificate    *InputFile `json:"certificate,omitempty"`
+	MaxConnections int        `json:"max_connections,omitempty"`
+	SecretToken    string     `json:"secret_token,omitempty"`
+	AllowedUpdates []string   `json:"allowed_updates,omitempty"`
 }
 
 // WebhookInfoOpt  - options for WebhookInfo
@@ -67,117 +59,298 @@ type WebhookInfoOpt struct {
 
 // SendAudioOpt - options for SendAudio
 type SendAudioOpt struct {
-	ChatID              string `json:"chat_id"`
-	Audio               string `json:"audio"`
-	Caption             string `json:"caption,omitempty"`
-	Duration            int    `json:"duration,omitempty"`
-	Performer           string `json:"performer,omitempty"`
-	Title               string `json:"title,omitempty"`
-	DisableNotification bool   `json:"disable_notification,omitempty"`
-	ReplyToMessageID    int    `json:"reply_to_message_id,omitempty"`
-	// reply_markup				Optional	Additional interface options. A JSON-serialized object for an inline
-	//				InlineKeyboardMarkup	keyboard, custom reply keyboard, instructions to remove reply keyboard
-	//				or ReplyKeyboardMarkup	or to force a reply from the user.
-	//				or ReplyKeyboardRemove
-	//				or ForceReply
+	ChatID              string      `json:"chat_id"`
+	Audio               *InputFile  `json:"audio"`
+	Caption             string      `json:"caption,omitempty"`
+	Duration            int         `json:"duration,omitempty"`
+	Performer           string      `json:"performer,omitempty"`
+	Title               string      `json:"title,omitempty"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
 // SendDocumentOpt - options for sendDocument
 type SendDocumentOpt struct {
-	ChatID              string `json:"chat_id"`
-	Document            string `json:"document"`
-	Caption             string `json:"caption,omitempty"`
-	DisableNotification bool   `json:"disable_notification,omitempty"`
-	ReplyToMessageID    int    `json:"reply_to_message_id,omitempty"`
-	// reply_markup				Optional	Additional interface options. A JSON-serialized object for an inline
-	//				InlineKeyboardMarkup	keyboard, custom reply keyboard, instructions to remove reply keyboard
-	//				or ReplyKeyboardMarkup	or to force a reply from the user.
-	//				or ReplyKeyboardRemove
-	//				or ForceReply
+	ChatID              string      `json:"chat_id"`
+	Document            *InputFile  `json:"document"`
+	Caption             string      `json:"caption,omitempty"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
 // SendVideoOpt - options for sendVideo
 type SendVideoOpt struct {
-	ChatID              string `json:"chat_id"`
-	Video               string `json:"video"`
-	Duration            int    `json:"duration,omitempty"`
-	Width               int    `json:"width,omitempty"`
-	Height              int    `json:"height,omitempty"`
-	Caption             string `json:"caption,omitempty"`
-	DisableNotification bool   `json:"disable_notification,omitempty"`
-	ReplyToMessageID    int    `json:"reply_to_message_id,omitempty"`
-	// reply_markup					Optional	Additional interface options. A JSON-serialized object for an inline keyboard,
-	//			InlineKeyboardMarkup 			custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
-	//			or ReplyKeyboardMarkup
-	//			or ReplyKeyboardRemove
-	//			or ForceReply
+	ChatID              string      `json:"chat_id"`
+	Video               *InputFile  `json:"video"`
+	Duration            int         `json:"duration,omitempty"`
+	Width               int         `json:"width,omitempty"`
+	Height              int         `json:"height,omitempty"`
+	Caption             string      `json:"caption,omitempty"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
 // SendVoiceOpt - options for sendVoice
 type SendVoiceOpt struct {
-	ChatID              string `json:"chat_id"`
-	Voice               string `json:"voice"`
-	Caption             string `json:"caption,omitempty"`
-	Duration            int    `json:"duration,omitempty"`
-	DisableNotification bool   `json:"disable_notification,omitempty"`
-	ReplyToMessageID    int    `json:"reply_to_message_id,omitempty"`
-	// reply_markup					Optional	Additional interface options. A JSON-serialized object for an inline keyboard,
-	//			InlineKeyboardMarkup 			custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
-	//			or ReplyKeyboardMarkup
-	//			or ReplyKeyboardRemove
-	//			or ForceReply
+	ChatID              string      `json:"chat_id"`
+	Voice               *InputFile  `json:"voice"`
+	Caption             string      `json:"caption,omitempty"`
+	Duration            int         `json:"duration,omitempty"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
 // SendVideoNoteOpt - options for sendVideo
 type SendVideoNoteOpt struct {
-	ChatID              string `json:"chat_id"`
-	VideoNote           string `json:"video_note"`
-	Duration            int    `json:"duration,omitempty"`
-	Length              int    `json:"length,omitempty"`
-	DisableNotification bool   `json:"disable_notification,omitempty"`
-	ReplyToMessageID    int    `json:"reply_to_message_id,omitempty"`
-	// reply_markup					Optional	Additional interface options. A JSON-serialized object for an inline keyboard,
-	//			InlineKeyboardMarkup 			custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
-	//			or ReplyKeyboardMarkup
-	//			or ReplyKeyboardRemove
-	//			or ForceReply
+	ChatID              string      `json:"chat_id"`
+	VideoNote           *InputFile  `json:"video_note"`
+	Duration            int         `json:"duration,omitempty"`
+	Length              int         `json:"length,omitempty"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendStickerOpt - options for SendSticker
+type SendStickerOpt struct {
+	ChatID              string      `json:"chat_id"`
+	Sticker             *InputFile  `json:"sticker"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendMediaGroupOpt - options for SendMediaGroup
+type SendMediaGroupOpt struct {
+	ChatID              string       `json:"chat_id"`
+	Media               []InputMedia `json:"media"`
+	DisableNotification bool         `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int          `json:"reply_to_message_id,omitempty"`
 }
 
 // SendLocationOpt - option for sendLocation
 type SendLocationOpt struct {
-	ChatID              string  `json:"chat_id"`
-	Latitude            float64 `json:"latitude"`
-	Longitude           float64 `json:"longitude"`
-	DisableNotification bool    `json:"disable_notification,omitempty"`
-	ReplyToMessageID    int     `json:"reply_to_message_id,omitempty"`
-	// reply_markup		InlineKeyboardMarkup 	Additional interface options. A JSON-serialized object for an inline
-	//				or ReplyKeyboardMarkup 		keyboard, custom reply keyboard, instructions to remove reply keyboard
-	//				or ReplyKeyboardRemove 		or to force a reply from the user.
-	//				or ForceReply	Optional
+	ChatID              string      `json:"chat_id"`
+	Latitude            float64     `json:"latitude"`
+	Longitude           float64     `json:"longitude"`
+	LivePeriod          int         `json:"live_period,omitempty"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
 // SendVenueOpt - options for SendVenue
 type SendVenueOpt struct {
-	ChatID              string  `json:"chat_id"`
-	Latitude            float64 `json:"latitude"`
-	Longitude           float64 `json:"longitude"`
-	Title               string  `json:"title"`
-	Address             string  `json:"address"`
-	FoursquareID        string  `json:"foursquare_id,omitempty"`
-	DisableNotification bool    `json:"disable_notification,omitempty"`
-	ReplyToMessageID    int     `json:"reply_to_message_id,omitempty"`
-	// reply_markup		InlineKeyboardMarkup 	Additional interface options. A JSON-serialized object for an inline
-	//				or ReplyKeyboardMarkup 		keyboard, custom reply keyboard, instructions to remove reply keyboard
-	//				or ReplyKeyboardRemove 		or to force a reply from the user.
-	//				or ForceReply	Optional
+	ChatID              string      `json:"chat_id"`
+	Latitude            float64     `json:"latitude"`
+	Longitude           float64     `json:"longitude"`
+	Title               string      `json:"title"`
+	Address             string      `json:"address"`
+	FoursquareID        string      `json:"foursquare_id,omitempty"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// KickChatMemberOpt - options for KickChatMember
+type KickChatMemberOpt struct {
+	ChatID    string `json:"chat_id"`
+	UserID    int    `json:"user_id"`
+	UntilDate int64  `json:"until_date,omitempty"`
+}
+
+// RestrictChatMemberOpt - options for RestrictChatMember, permissions which are not set are restricted
+type RestrictChatMemberOpt struct {
+	ChatID                string `json:"chat_id"`
+	UserID                int    `json:"user_id"`
+	UntilDate             int64  `json:"until_date,omitempty"`
+	CanSendMessages       bool   `json:"can_send_messages"`
+	CanSendMediaMessages  bool   `json:"can_send_media_messages"`
+	CanSendOtherMessages  bool   `json:"can_send_other_messages"`
+	CanAddWebPagePreviews bool   `json:"can_add_web_page_previews"`
+}
+
+// PromoteChatMemberOpt - options for PromoteChatMember, admin rights which are not set are revoked
+type PromoteChatMemberOpt struct {
+	ChatID             string `json:"chat_id"`
+	UserID             int    `json:"user_id"`
+	CanChangeInfo      bool   `json:"can_change_info"`
+	CanPostMessages    bool   `json:"can_post_messages"`
+	CanEditMessages    bool   `json:"can_edit_messages"`
+	CanDeleteMessages  bool   `json:"can_delete_messages"`
+	CanInviteUsers     bool   `json:"can_invite_users"`
+	CanRestrictMembers bool   `json:"can_restrict_members"`
+	CanPinMessages     bool   `json:"can_pin_messages"`
+	CanPromoteMembers  bool   `json:"can_promote_members"`
+}
+
+// PinChatMessageOpt - options for PinChatMessage
+type PinChatMessageOpt struct {
+	ChatID              string `json:"chat_id"`
+	MessageID           int    `json:"message_id"`
+	DisableNotification bool   `json:"disable_notification,omitempty"`
+}
+
+// AnswerCallbackQueryOpt - options for AnswerCallbackQuery
+type AnswerCallbackQueryOpt struct {
+	CallbackQueryID string `json:"callback_query_id"`
+	Text            string `json:"text,omitempty"`
+	ShowAlert       bool   `json:"show_alert,omitempty"`
+	URL             string `json:"url,omitempty"`
+	CacheTime       int    `json:"cache_time,omitempty"`
+}
+
+// AnswerInlineQueryOpt - options for AnswerInlineQuery
+type AnswerInlineQueryOpt struct {
+	InlineQueryID     string              `json:"inline_query_id"`
+	Results           []InlineQueryResult `json:"results"`
+	CacheTime         int                 `json:"cache_time,omitempty"`
+	IsPersonal        bool                `json:"is_personal,omitempty"`
+	NextOffset        string              `json:"next_offset,omitempty"`
+	SwitchPmText      string              `json:"switch_pm_text,omitempty"`
+	SwitchPmParameter string              `json:"switch_pm_parameter,omitempty"`
+}
+
+// EditMessageTextOpt - options for EditMessageText, set InlineMessageID or ChatID and MessageID
+type EditMessageTextOpt struct {
+	ChatID                string                `json:"chat_id,omitempty"`
+	MessageID             int                   `json:"message_id,omitempty"`
+	InlineMessageID       string                `json:"inline_message_id,omitempty"`
+	Text                  string                `json:"text"`
+	ParseMode             string                `json:"parse_mode,omitempty"`
+	DisableWebPagePreview bool                  `json:"disable_web_page_preview,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageCaptionOpt - options for EditMessageCaption, set InlineMessageID or ChatID and MessageID
+type EditMessageCaptionOpt struct {
+	ChatID          string                `json:"chat_id,omitempty"`
+	MessageID       int                   `json:"message_id,omitempty"`
+	InlineMessageID string                `json:"inline_message_id,omitempty"`
+	Caption         string                `json:"caption,omitempty"`
+	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageReplyMarkupOpt - options for EditMessageReplyMarkup, set InlineMessageID or ChatID and
+// MessageID
+type EditMessageReplyMarkupOpt struct {
+	ChatID          string                `json:"chat_id,omitempty"`
+	MessageID       int                   `json:"message_id,omitempty"`
+	InlineMessageID string                `json:"inline_message_id,omitempty"`
+	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageLiveLocationOpt - options for EditMessageLiveLocation, set InlineMessageID or ChatID and
+// MessageID
+type EditMessageLiveLocationOpt struct {
+	ChatID          string                `json:"chat_id,omitempty"`
+	MessageID       int                   `json:"message_id,omitempty"`
+	InlineMessageID string                `json:"inline_message_id,omitempty"`
+	Latitude        float64               `json:"latitude"`
+	Longitude       float64               `json:"longitude"`
+	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// StopMessageLiveLocationOpt - options for StopMessageLiveLocation, set InlineMessageID or ChatID and
+// MessageID
+type StopMessageLiveLocationOpt struct {
+	ChatID          string                `json:"chat_id,omitempty"`
+	MessageID       int                   `json:"message_id,omitempty"`
+	InlineMessageID string                `json:"inline_message_id,omitempty"`
+	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
 // ---------------------
 
 // SendGameOpt - options for SendGame
 type SendGameOpt struct {
-	ChatID              string `json:"chat_id"`
-	GameShortName       string `json:"game_short_name"`
-	DisableNotification bool   `json:"disable_notification,omitempty"`
-	ReplyToMessageID    int    `json:"reply_to_message_id,omitempty"`
+	ChatID              string                `json:"chat_id"`
+	GameShortName       string                `json:"game_short_name"`
+	DisableNotification bool                  `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int                   `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// SetGameScoreOpt - options for SetGameScore, set InlineMessageID or ChatID and MessageID
+type SetGameScoreOpt struct {
+	UserID             int    `json:"user_id"`
+	Score              int    `json:"score"`
+	Force              bool   `json:"force,omitempty"`
+	DisableEditMessage bool   `json:"disable_edit_message,omitempty"`
+	ChatID             string `json:"chat_id,omitempty"`
+	MessageID          int    `json:"message_id,omitempty"`
+	InlineMessageID    string `json:"inline_message_id,omitempty"`
+}
+
+// GetGameHighScoresOpt - options for GetGameHighScores, set InlineMessageID or ChatID and MessageID
+type GetGameHighScoresOpt struct {
+	UserID          int    `json:"user_id"`
+	ChatID          string `json:"chat_id,omitempty"`
+	MessageID       int    `json:"message_id,omitempty"`
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// SendInvoiceOpt - options for SendInvoice
+type SendInvoiceOpt struct {
+	ChatID              string                `json:"chat_id"`
+	Title               string                `json:"title"`
+	Description         string                `json:"description"`
+	Payload             string                `json:"payload"`
+	ProviderToken       string                `json:"provider_token"`
+	StartParameter      string                `json:"start_parameter"`
+	Currency            string                `json:"currency"`
+	Prices              []*LabeledPrice       `json:"prices"`
+	ProviderData        string                `json:"provider_data,omitempty"`
+	PhotoURL            string                `json:"photo_url,omitempty"`
+	PhotoSize           int                   `json:"photo_size,omitempty"`
+	PhotoWidth          int                   `json:"photo_width,omitempty"`
+	PhotoHeight         int                   `json:"photo_height,omitempty"`
+	NeedName            bool                  `json:"need_name,omitempty"`
+	NeedPhoneNumber     bool                  `json:"need_phone_number,omitempty"`
+	NeedEmail           bool                  `json:"need_email,omitempty"`
+	NeedShippingAddress bool                  `json:"need_shipping_address,omitempty"`
+	IsFlexible          bool                  `json:"is_flexible,omitempty"`
+	DisableNotification bool                  `json:"disable_notification,omitempty"`
+	ReplyToMessageID    int                   `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// AnswerShippingQueryOpt - options for AnswerShippingQuery, set ShippingOptions if Ok or ErrorMessage
+// otherwise
+type AnswerShippingQueryOpt struct {
+	ShippingQueryID string            `json:"shipping_query_id"`
+	Ok              bool              `json:"ok"`
+	ShippingOptions []*ShippingOption `json:"shipping_options,omitempty"`
+	ErrorMessage    string            `json:"error_message,omitempty"`
+}
+
+// AnswerPreCheckoutQueryOpt - options for AnswerPreCheckoutQuery, set ErrorMessage if not Ok
+type AnswerPreCheckoutQueryOpt struct {
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"`
+	Ok                 bool   `json:"ok"`
+	ErrorMessage       string `json:"error_message,omitempty"`
+}
+
+// CreateNewStickerSetOpt - options for CreateNewStickerSet
+type CreateNewStickerSetOpt struct {
+	UserID        int           `json:"user_id"`
+	Name          string        `json:"name"`
+	Title         string        `json:"title"`
+	PngSticker    *InputFile    `json:"png_sticker"`
+	Emojis        string        `json:"emojis"`
+	ContainsMasks bool          `json:"contains_masks,omitempty"`
+	MaskPosition  *MaskPosition `json:"mask_position,omitempty"`
+}
+
+// AddStickerToSetOpt - options for AddStickerToSet
+type AddStickerToSetOpt struct {
+	UserID       int           `json:"user_id"`
+	Name         string        `json:"name"`
+	PngSticker   *InputFile    `json:"png_sticker"`
+	Emojis       string        `json:"emojis"`
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
 }
@@ -0,0 +1,124 @@
+package telego
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+)
+
+const (
+	// MaxInlineQueryResults - maximum number of results in one answer to an inline query
+	MaxInlineQueryResults = 50
+	// MaxInlineQueryOffset - maximum length of next_offset in bytes
+	MaxInlineQueryOffset = 64
+)
+
+// InlinePageFunc - produce at most limit results for the query starting from the result number offset.
+// Fewer than limit results mean that there are no more results.
+type InlinePageFunc func(ctx context.Context, q *InlineQuery, offset, limit int) ([]InlineQueryResult, error)
+
+// InlineCursorFunc - produce at most limit results for the query starting from cursor, cursor is empty
+// for the first page. Returns the cursor of the next page, an empty next cursor means that there are no
+// more results.
+type InlineCursorFunc func(ctx context.Context, q *InlineQuery, cursor string, limit int) (results []InlineQueryResult, next string, err error)
+
+// AnswerPage - answer the query with a page of results produced by page, the page starts from the number
+// in the Offset of the query. next_offset is set to the number of the first result of the next page if
+// page returned a full page. pageSize is limited to MaxInlineQueryResults, 0 means MaxInlineQueryResults.
+//
+// opt may be nil, InlineQueryID, Results and NextOffset are set from the query and the page. Returns
+// ErrInvalidOffset without answering the query if Offset is not a number.
+func (q *InlineQuery) AnswerPage(ctx context.Context, bot *Bot, pageSize int, page InlinePageFunc, opt *AnswerInlineQueryOpt) (bool, error) {
+	offset := 0
+	if q.Offset != "" {
+		var err error
+		offset, err = strconv.Atoi(q.Offset)
+		if err != nil || offset < 0 {
+			errLog("AnswerPage strconv.Atoi", ErrInvalidOffset)
+			return false, ErrInvalidOffset
+		}
+	}
+	limit := inlinePageSize(pageSize)
+	results, err := page(ctx, q, offset, limit)
+	if err != nil {
+		errLog("AnswerPage page", err)
+		return false, err
+	}
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	var next string
+	if len(results) == limit {
+		next = strconv.Itoa(offset + limit)
+	}
+	return q.answerPage(ctx, bot, results, next, opt)
+}
+
+// AnswerCursor - answer the query with a page of results produced by page for backends that paginate with
+// cursors instead of numbers. The cursor is passed to the client encoded with EncodeInlineCursor, so it
+// must not be longer than 48 bytes. pageSize is limited to MaxInlineQueryResults, 0 means
+// MaxInlineQueryResults.
+//
+// opt may be nil, InlineQueryID, Results and NextOffset are set from the query and the page. Returns
+// ErrInvalidOffset without answering the query if Offset is not a valid cursor or the next cursor is too
+// long.
+func (q *InlineQuery) AnswerCursor(ctx context.Context, bot *Bot, pageSize int, page InlineCursorFunc, opt *AnswerInlineQueryOpt) (bool, error) {
+	cursor, err := DecodeInlineCursor(q.Offset)
+	if err != nil {
+		errLog("AnswerCursor DecodeInlineCursor", err)
+		return false, err
+	}
+	limit := inlinePageSize(pageSize)
+	results, next, err := page(ctx, q, cursor, limit)
+	if err != nil {
+		errLog("AnswerCursor page", err)
+		return false, err
+	}
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	nextOffset, err := EncodeInlineCursor(next)
+	if err != nil {
+		errLog("AnswerCursor EncodeInlineCursor", err)
+		return false, err
+	}
+	return q.answerPage(ctx, bot, results, nextOffset, opt)
+}
+
+// EncodeInlineCursor - encode cursor as an opaque offset that can be used as next_offset. Returns
+// ErrInvalidOffset if the encoded cursor is longer than MaxInlineQueryOffset.
+func EncodeInlineCursor(cursor string) (string, error) {
+	offset := base64.RawURLEncoding.EncodeToString([]byte(cursor))
+	if len(offset) > MaxInlineQueryOffset {
+		return "", ErrInvalidOffset
+	}
+	return offset, nil
+}
+
+// DecodeInlineCursor - decode the cursor from the offset of an inline query, an empty offset is decoded
+// to an empty cursor
+func DecodeInlineCursor(offset string) (string, error) {
+	cursor, err := base64.RawURLEncoding.DecodeString(offset)
+	if err != nil {
+		return "", ErrInvalidOffset
+	}
+	return string(cursor), nil
+}
+
+func (q *InlineQuery) answerPage(ctx context.Context, bot *Bot, results []InlineQueryResult, next string, opt *AnswerInlineQueryOpt) (bool, error) {
+	var answerOpt AnswerInlineQueryOpt
+	if opt != nil {
+		answerOpt = *opt
+	}
+	answerOpt.InlineQueryID = q.ID
+	answerOpt.Results = results
+	answerOpt.NextOffset = next
+	return bot.WithContext(ctx).AnswerInlineQuery(&answerOpt)
+}
+
+func inlinePageSize(pageSize int) int {
+	if pageSize <= 0 || pageSize > MaxInlineQueryResults {
+		return MaxInlineQueryResults
+	}
+	return pageSize
+}
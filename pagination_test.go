@@ -0,0 +1,188 @@
+package telego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestInlineCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cursor  string
+		wantErr bool
+	}{
+		{"empty", "", false},
+		{"key", "2017-11-17T10:00:00Z/42", false},
+		{"binary", "\x00\xff\xfe", false},
+		{"longest", strings.Repeat("x", 48), false},
+		{"too long", strings.Repeat("x", 49), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset, err := EncodeInlineCursor(tt.cursor)
+			if tt.wantErr {
+				if err != ErrInvalidOffset {
+					t.Errorf("EncodeInlineCursor() err = %v, want ErrInvalidOffset", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(offset) > MaxInlineQueryOffset {
+				t.Errorf("len(offset) = %d, want at most %d", len(offset), MaxInlineQueryOffset)
+			}
+			if tt.cursor == "" && offset != "" {
+				t.Errorf("offset of empty cursor = %q, want empty", offset)
+			}
+			cursor, err := DecodeInlineCursor(offset)
+			if err != nil || cursor != tt.cursor {
+				t.Errorf("DecodeInlineCursor(%q) = %q, %v, want %q", offset, cursor, err, tt.cursor)
+			}
+		})
+	}
+	if _, err := DecodeInlineCursor("not base64!"); err != ErrInvalidOffset {
+		t.Errorf("DecodeInlineCursor() err = %v, want ErrInvalidOffset", err)
+	}
+}
+
+// answerStub - stub of answerInlineQuery recording the answers
+func answerStub(t *testing.T, answers *[]AnswerInlineQueryOpt) *Bot {
+	return newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if apiMethod(r) != "answerInlineQuery" {
+			t.Errorf("method = %s, want answerInlineQuery", apiMethod(r))
+		}
+		var results []json.RawMessage
+		err := json.Unmarshal([]byte(r.FormValue("results")), &results)
+		if err != nil {
+			t.Errorf("results: %v", err)
+		}
+		cacheTime, _ := strconv.Atoi(r.FormValue("cache_time"))
+		*answers = append(*answers, AnswerInlineQueryOpt{
+			InlineQueryID: r.FormValue("inline_query_id"),
+			Results:       make([]InlineQueryResult, len(results)),
+			NextOffset:    r.FormValue("next_offset"),
+			CacheTime:     cacheTime,
+		})
+		writeResult(w, true)
+	})
+}
+
+func articles(from, to int) []InlineQueryResult {
+	var results []InlineQueryResult
+	for i := from; i < to; i++ {
+		results = append(results, InlineQueryResultArticle{
+			ID:                  strconv.Itoa(i),
+			Title:               strconv.Itoa(i),
+			InputMessageContent: InputTextMessageContent{MessageText: strconv.Itoa(i)},
+		})
+	}
+	return results
+}
+
+func TestAnswerPage(t *testing.T) {
+	const total = 23
+	page := func(ctx context.Context, q *InlineQuery, offset, limit int) ([]InlineQueryResult, error) {
+		end := offset + limit
+		if end > total {
+			end = total
+		}
+		if offset > end {
+			offset = end
+		}
+		return articles(offset, end), nil
+	}
+	tests := []struct {
+		name        string
+		offset      string
+		pageSize    int
+		wantResults int
+		wantNext    string
+		wantErr     error
+	}{
+		{"first page", "", 10, 10, "10", nil},
+		{"middle page", "10", 10, 10, "20", nil},
+		{"last page", "20", 10, 3, "", nil},
+		{"after the end", "30", 10, 0, "", nil},
+		{"default page size", "", 0, 23, "", nil},
+		{"page size over the limit", "", 100, 23, "", nil},
+		{"invalid offset", "abc", 10, 0, "", ErrInvalidOffset},
+		{"negative offset", "-10", 10, 0, "", ErrInvalidOffset},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var answers []AnswerInlineQueryOpt
+			bot := answerStub(t, &answers)
+			q := &InlineQuery{ID: "q1", Offset: tt.offset}
+			_, err := q.AnswerPage(context.Background(), bot, tt.pageSize, page, &AnswerInlineQueryOpt{CacheTime: 10})
+			if err != tt.wantErr {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(answers) != 0 {
+					t.Error("query answered after an error")
+				}
+				return
+			}
+			if len(answers) != 1 {
+				t.Fatalf("%d answers, want 1", len(answers))
+			}
+			answer := answers[0]
+			if answer.InlineQueryID != "q1" || len(answer.Results) != tt.wantResults ||
+				answer.NextOffset != tt.wantNext || answer.CacheTime != 10 {
+				t.Errorf("answer = id %s, %d results, next_offset %q, want %d results, next_offset %q",
+					answer.InlineQueryID, len(answer.Results), answer.NextOffset, tt.wantResults, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestAnswerCursor(t *testing.T) {
+	pages := map[string]struct {
+		results []InlineQueryResult
+		next    string
+	}{
+		"":      {articles(0, 5), "key:5"},
+		"key:5": {articles(5, 7), ""},
+	}
+	page := func(ctx context.Context, q *InlineQuery, cursor string, limit int) ([]InlineQueryResult, string, error) {
+		p := pages[cursor]
+		return p.results, p.next, nil
+	}
+	var answers []AnswerInlineQueryOpt
+	bot := answerStub(t, &answers)
+	q := &InlineQuery{ID: "q1"}
+	for {
+		_, err := q.AnswerCursor(context.Background(), bot, 5, page, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		next := answers[len(answers)-1].NextOffset
+		if next == "" {
+			break
+		}
+		if next == "key:5" {
+			t.Fatal("cursor is not encoded")
+		}
+		q.Offset = next
+	}
+	if len(answers) != 2 || len(answers[0].Results) != 5 || len(answers[1].Results) != 2 {
+		t.Errorf("answers = %+v, want pages of 5 and 2 results", answers)
+	}
+
+	q.Offset = "%%%"
+	if _, err := q.AnswerCursor(context.Background(), bot, 5, page, nil); err != ErrInvalidOffset {
+		t.Errorf("err = %v for invalid offset, want ErrInvalidOffset", err)
+	}
+	long := func(ctx context.Context, q *InlineQuery, cursor string, limit int) ([]InlineQueryResult, string, error) {
+		return articles(0, 1), strings.Repeat("x", 100), nil
+	}
+	q.Offset = ""
+	if _, err := q.AnswerCursor(context.Background(), bot, 5, long, nil); err != ErrInvalidOffset {
+		t.Errorf("err = %v for too long cursor, want ErrInvalidOffset", err)
+	}
+}
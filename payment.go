@@ -1,5 +1,223 @@
 package telego
 
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// SendInvoice - "sendInvoice" Use this method to send invoices. On success, the sent Message is returned.
+//
+// chat_id					Integer		Yes			Unique identifier for the target private chat
+// title					String		Yes			Product name, 1-32 characters
+// description				String		Yes			Product description, 1-255 characters
+// payload					String		Yes			Bot-defined invoice payload, 1-128 bytes. This will not be
+//												displayed to the user, use for your internal processes.
+// provider_token			String		Yes			Payments provider token, obtained via Botfather
+// start_parameter			String		Yes			Unique deep-linking parameter that can be used to generate this
+//												invoice when used as a start parameter
+// currency					String		Yes			Three-letter ISO 4217 currency code
+// prices					Array of	Yes			Price breakdown, a list of components (e.g. product price, tax,
+//							LabeledPrice		discount, delivery cost, delivery tax, bonus, etc.)
+// provider_data			String		Optional	JSON-encoded data about the invoice, which will be shared with
+//												the payment provider. A detailed description of required fields
+//												should be provided by the payment provider.
+// photo_url				String		Optional	URL of the product photo for the invoice. Can be a photo of the
+//												goods or a marketing image for a service.
+// photo_size				Integer		Optional	Photo size
+// photo_width				Integer		Optional	Photo width
+// photo_height				Integer		Optional	Photo height
+// need_name				Boolean		Optional	Pass True, if you require the user's full name to complete the order
+// need_phone_number		Boolean		Optional	Pass True, if you require the user's phone number to complete the
+//												order
+// need_email				Boolean		Optional	Pass True, if you require the user's email to complete the order
+// need_shipping_address	Boolean		Optional	Pass True, if you require the user's shipping address to complete
+//												the order
+// is_flexible				Boolean		Optional	Pass True, if the final price depends on the shipping method
+// disable_notification		Boolean		Optional	Sends the message silently. Users will receive a notification with
+//												no sound.
+// reply_to_message_id		Integer		Optional	If the message is a reply, ID of the original message
+// reply_markup				InlineKeyboardMarkup	Optional	A JSON-serialized object for an inline keyboard. If
+//												empty, one 'Pay total price' button will be shown. If not empty,
+//												the first button must be a Pay button.
+func (bot *Bot) SendInvoice(opt *SendInvoiceOpt) (Message, error) {
+	if opt.ChatID == "" || opt.Title == "" || opt.Description == "" || opt.Payload == "" ||
+		opt.ProviderToken == "" || opt.StartParameter == "" || opt.Currency == "" || len(opt.Prices) == 0 {
+		return Message{}, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("chat_id", opt.ChatID)
+	values.Set("title", opt.Title)
+	values.Set("description", opt.Description)
+	values.Set("payload", opt.Payload)
+	values.Set("provider_token", opt.ProviderToken)
+	values.Set("start_parameter", opt.StartParameter)
+	values.Set("currency", opt.Currency)
+	err := setJSONValue(values, "prices", opt.Prices)
+	if err != nil {
+		errLog("SendInvoice setJSONValue", err)
+		return Message{}, err
+	}
+	if opt.ProviderData != "" {
+		values.Set("provider_data", opt.ProviderData)
+	}
+	if opt.PhotoURL != "" {
+		values.Set("photo_url", opt.PhotoURL)
+	}
+	if opt.PhotoSize > 0 {
+		values.Set("photo_size", strconv.Itoa(opt.PhotoSize))
+	}
+	if opt.PhotoWidth > 0 {
+		values.Set("photo_width", strconv.Itoa(opt.PhotoWidth))
+	}
+	if opt.PhotoHeight > 0 {
+		values.Set("photo_height", strconv.Itoa(opt.PhotoHeight))
+	}
+	if opt.NeedName {
+		values.Set("need_name", "true")
+	}
+	if opt.NeedPhoneNumber {
+		values.Set("need_phone_number", "true")
+	}
+	if opt.NeedEmail {
+		values.Set("need_email", "true")
+	}
+	if opt.NeedShippingAddress {
+		values.Set("need_shipping_address", "true")
+	}
+	if opt.IsFlexible {
+		values.Set("is_flexible", "true")
+	}
+	if opt.DisableNotification {
+		values.Set("disable_notification", "true")
+	}
+	if opt.ReplyToMessageID > 0 {
+		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
+	}
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendInvoice setJSONValue", err)
+			return Message{}, err
+		}
+	}
+	r, err := bot.createResponse("sendInvoice", values)
+	if err != nil {
+		errLog("SendInvoice createResponse", err)
+		return Message{}, err
+	}
+	var message Message
+	err = json.Unmarshal(r.Result, &message)
+	if err != nil {
+		errLog("SendInvoice Unmarshal", err)
+	}
+	return message, err
+}
+
+// AnswerShippingQuery - "answerShippingQuery" If you sent an invoice requesting a shipping address and the
+// parameter is_flexible was specified, the Bot API will send an Update with a shipping_query field to the bot.
+// Use this method to reply to shipping queries. On success, True is returned.
+//
+// shipping_query_id	String		Yes			Unique identifier for the query to be answered
+// ok					Boolean		Yes			Specify True if delivery to the specified address is possible and
+//											False if there are any problems (for example, if delivery to the
+//											specified address is not possible)
+// shipping_options		Array of	Optional	Required if ok is True. A JSON-serialized array of available
+//						ShippingOption		shipping options.
+// error_message		String		Optional	Required if ok is False. Error message in human readable form that
+//											explains why it is impossible to complete the order (e.g. "Sorry,
+//											delivery to your desired address is unavailable'). Telegram will
+//											display this message to the user.
+func (bot *Bot) AnswerShippingQuery(opt *AnswerShippingQueryOpt) (bool, error) {
+	if opt.ShippingQueryID == "" || (opt.Ok && len(opt.ShippingOptions) == 0) || (!opt.Ok && opt.ErrorMessage == "") {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("shipping_query_id", opt.ShippingQueryID)
+	values.Set("ok", strconv.FormatBool(opt.Ok))
+	if opt.Ok {
+		err := setJSONValue(values, "shipping_options", opt.ShippingOptions)
+		if err != nil {
+			errLog("AnswerShippingQuery setJSONValue", err)
+			return false, err
+		}
+	} else {
+		values.Set("error_message", opt.ErrorMessage)
+	}
+	r, err := bot.createResponse("answerShippingQuery", values)
+	if err != nil {
+		errLog("AnswerShippingQuery createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("AnswerShippingQuery Unmarshal", err)
+	}
+	return result, err
+}
+
+// AnswerPreCheckoutQuery - "answerPreCheckoutQuery" Once the user has confirmed their payment and shipping
+// details, the Bot API sends the final confirmation in the form of an Update with the field
+// pre_checkout_query. Use this method to respond to such pre-checkout queries. On success, True is returned.
+// Note: The Bot API must receive an answer within 10 seconds after the pre-checkout query was sent.
+//
+// pre_checkout_query_id	String	Yes			Unique identifier for the query to be answered
+// ok						Boolean	Yes			Specify True if everything is alright (goods are available, etc.)
+//											and the bot is ready to proceed with the order. Use False if there
+//											are any problems.
+// error_message			String	Optional	Required if ok is False. Error message in human readable form that
+//											explains the reason for failure to proceed with the checkout (e.g.
+//											"Sorry, somebody just bought the last of our amazing black T-shirts
+//											while you were busy filling out your payment details. Please choose
+//											a different color or garment!"). Telegram will display this message
+//											to the user.
+func (bot *Bot) AnswerPreCheckoutQuery(opt *AnswerPreCheckoutQueryOpt) (bool, error) {
+	if opt.PreCheckoutQueryID == "" || (!opt.Ok && opt.ErrorMessage == "") {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("pre_checkout_query_id", opt.PreCheckoutQueryID)
+	values.Set("ok", strconv.FormatBool(opt.Ok))
+	if !opt.Ok {
+		values.Set("error_message", opt.ErrorMessage)
+	}
+	r, err := bot.createResponse("answerPreCheckoutQuery", values)
+	if err != nil {
+		errLog("AnswerPreCheckoutQuery createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("AnswerPreCheckoutQuery Unmarshal", err)
+	}
+	return result, err
+}
+
+// LabeledPrice - This object represents a portion of the price for goods or services.
+//
+// label	String	Portion label
+// amount	Integer	Price of the product in the smallest units of the currency (integer, not float/double). For
+//					example, for a price of US$ 1.45 pass amount = 145. See the exp parameter in currencies.json,
+//					it shows the number of digits past the decimal point for each currency (2 for the majority
+//					of currencies).
+type LabeledPrice struct {
+	Label  string `json:"label"`
+	Amount int    `json:"amount"`
+}
+
+// ShippingOption - This object represents one shipping option.
+//
+// id		String					Shipping option identifier
+// title	String					Option title
+// prices	Array of LabeledPrice	List of price portions
+type ShippingOption struct {
+	ID     string          `json:"id"`
+	Title  string          `json:"title"`
+	Prices []*LabeledPrice `json:"prices"`
+}
+
 // Invoice - This object contains basic information about an invoice.
 //
 // title			String	Product name
@@ -11,7 +229,7 @@ package telego
 //							in currencies.json, it shows the number of digits past the decimal point for
 //							each currency (2 for the majority of currencies).
 type Invoice struct {
-	Title          string `json:"user"`
+	Title          string `json:"title"`
 	Description    string `json:"description"`
 	StartParameter string `json:"start_parameter"`
 	Currency       string `json:"currency"`
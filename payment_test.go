@@ -0,0 +1,148 @@
+package telego
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSendInvoice(t *testing.T) {
+	bot, forms := formStub(t, Message{MessageID: 5, Invoice: &Invoice{Title: "T-shirt", TotalAmount: 1500}})
+	opt := &SendInvoiceOpt{
+		ChatID:              "42",
+		Title:               "T-shirt",
+		Description:         "Black T-shirt",
+		Payload:             "order-1",
+		ProviderToken:       "provider",
+		StartParameter:      "tshirt",
+		Currency:            "USD",
+		Prices:              []*LabeledPrice{{Label: "T-shirt", Amount: 1200}, {Label: "Tax", Amount: 300}},
+		ProviderData:        `{"receipt":true}`,
+		NeedShippingAddress: true,
+		IsFlexible:          true,
+		ReplyMarkup:         &InlineKeyboardMarkup{},
+	}
+	message, err := bot.SendInvoice(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.MessageID != 5 || message.Invoice == nil || message.Invoice.Title != "T-shirt" {
+		t.Errorf("message = %+v", message)
+	}
+	form := (*forms)[0]
+	want := map[string]string{
+		"method":                "sendInvoice",
+		"chat_id":               "42",
+		"payload":               "order-1",
+		"provider_token":        "provider",
+		"currency":              "USD",
+		"provider_data":         `{"receipt":true}`,
+		"need_shipping_address": "true",
+		"is_flexible":           "true",
+		"need_email":            "",
+	}
+	for key, value := range want {
+		if form.Get(key) != value {
+			t.Errorf("%s = %q, want %q", key, form.Get(key), value)
+		}
+	}
+	var prices []*LabeledPrice
+	err = json.Unmarshal([]byte(form.Get("prices")), &prices)
+	if err != nil || !reflect.DeepEqual(prices, opt.Prices) {
+		t.Errorf("prices = %s, want %v", form.Get("prices"), opt.Prices)
+	}
+
+	opt.Prices = nil
+	if _, err := bot.SendInvoice(opt); err != ErrMissingParam {
+		t.Errorf("err = %v without prices, want ErrMissingParam", err)
+	}
+	if len(*forms) != 1 {
+		t.Errorf("request sent without prices")
+	}
+}
+
+func TestAnswerShippingQuery(t *testing.T) {
+	options := []*ShippingOption{{ID: "post", Title: "Post", Prices: []*LabeledPrice{{Label: "Delivery", Amount: 500}}}}
+	tests := []struct {
+		name    string
+		opt     *AnswerShippingQueryOpt
+		want    map[string]string
+		wantErr error
+	}{
+		{"ok", &AnswerShippingQueryOpt{ShippingQueryID: "s1", Ok: true, ShippingOptions: options},
+			map[string]string{"shipping_query_id": "s1", "ok": "true", "error_message": ""}, nil},
+		{"error", &AnswerShippingQueryOpt{ShippingQueryID: "s1", ErrorMessage: "No delivery"},
+			map[string]string{"ok": "false", "error_message": "No delivery", "shipping_options": ""}, nil},
+		{"ok without options", &AnswerShippingQueryOpt{ShippingQueryID: "s1", Ok: true}, nil, ErrMissingParam},
+		{"error without message", &AnswerShippingQueryOpt{ShippingQueryID: "s1"}, nil, ErrMissingParam},
+		{"no id", &AnswerShippingQueryOpt{Ok: true, ShippingOptions: options}, nil, ErrMissingParam},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, forms := formStub(t, true)
+			ok, err := bot.AnswerShippingQuery(tt.opt)
+			if err != tt.wantErr {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if len(*forms) != 0 {
+					t.Error("request sent with missing params")
+				}
+				return
+			}
+			if !ok {
+				t.Error("result = false, want true")
+			}
+			form := (*forms)[0]
+			for key, value := range tt.want {
+				if form.Get(key) != value {
+					t.Errorf("%s = %q, want %q", key, form.Get(key), value)
+				}
+			}
+			if tt.opt.Ok {
+				var got []*ShippingOption
+				json.Unmarshal([]byte(form.Get("shipping_options")), &got)
+				if !reflect.DeepEqual(got, options) {
+					t.Errorf("shipping_options = %s", form.Get("shipping_options"))
+				}
+			}
+		})
+	}
+}
+
+func TestAnswerPreCheckoutQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		opt     *AnswerPreCheckoutQueryOpt
+		want    map[string]string
+		wantErr error
+	}{
+		{"ok", &AnswerPreCheckoutQueryOpt{PreCheckoutQueryID: "p1", Ok: true},
+			map[string]string{"pre_checkout_query_id": "p1", "ok": "true", "error_message": ""}, nil},
+		{"error", &AnswerPreCheckoutQueryOpt{PreCheckoutQueryID: "p1", ErrorMessage: "Sold out"},
+			map[string]string{"ok": "false", "error_message": "Sold out"}, nil},
+		{"error without message", &AnswerPreCheckoutQueryOpt{PreCheckoutQueryID: "p1"}, nil, ErrMissingParam},
+		{"no id", &AnswerPreCheckoutQueryOpt{Ok: true}, nil, ErrMissingParam},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, forms := formStub(t, true)
+			_, err := bot.AnswerPreCheckoutQuery(tt.opt)
+			if err != tt.wantErr {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			form := (*forms)[0]
+			if form.Get("method") != "answerPreCheckoutQuery" {
+				t.Errorf("method = %s", form.Get("method"))
+			}
+			for key, value := range tt.want {
+				if form.Get(key) != value {
+					t.Errorf("%s = %q, want %q", key, form.Get(key), value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package telego
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	pollingTimeout    = 30
+	pollingMinBackoff = time.Second
+	pollingMaxBackoff = time.Minute
+)
+
+// StartPolling - receive incoming updates with long polling in a separate goroutine and send them to
+// the returned channel. The offset is calculated from the update_id of received updates, so every
+// update is delivered once. Errors of getUpdates are logged and the request is repeated with an
+// exponential backoff. The channel is closed after ctx is cancelled, ctx is also used for getUpdates
+// requests, so a pending long polling request is cancelled too.
+//
+// opt may be nil. Offset is used as the starting offset, if Timeout is not set long polling with a
+// 30 seconds timeout is used.
+func (bot *Bot) StartPolling(ctx context.Context, opt *GetUpdatesOpt) <-chan Update {
+	var getOpt GetUpdatesOpt
+	if opt != nil {
+		getOpt = *opt
+	}
+	if getOpt.Timeout <= 0 {
+		getOpt.Timeout = pollingTimeout
+	}
+	updates := make(chan Update)
+	go func() {
+		defer close(updates)
+		ctxBot := bot.WithContext(ctx)
+		backoff := pollingMinBackoff
+		for ctx.Err() == nil {
+			result, err := ctxBot.GetUpdates(&getOpt)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				errLog("StartPolling GetUpdates", err)
+				if !sleepContext(ctx, backoff) {
+					return
+				}
+				backoff *= 2
+				if backoff > pollingMaxBackoff {
+					backoff = pollingMaxBackoff
+				}
+				continue
+			}
+			backoff = pollingMinBackoff
+			for _, update := range result {
+				if update.UpdateID >= getOpt.Offset {
+					getOpt.Offset = update.UpdateID + 1
+				}
+				select {
+				case updates <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return updates
+}
@@ -0,0 +1,94 @@
+package telego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestStartPollingOffset(t *testing.T) {
+	batches := map[string][]Update{
+		"":   {{UpdateID: 10}, {UpdateID: 11}},
+		"12": {{UpdateID: 12}},
+		"13": {{UpdateID: 15}, {UpdateID: 14}},
+	}
+	offsets := make(chan string, 100)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if apiMethod(r) != "getUpdates" {
+			t.Errorf("method = %s, want getUpdates", apiMethod(r))
+		}
+		offset := r.FormValue("offset")
+		offsets <- offset
+		updates, ok := batches[offset]
+		if !ok {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(50 * time.Millisecond):
+			}
+			updates = []Update{}
+		}
+		writeResult(w, updates)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := bot.StartPolling(ctx, nil)
+	var got []int
+	for len(got) < 5 {
+		select {
+		case update := <-updates:
+			got = append(got, update.UpdateID)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout, received %v", got)
+		}
+	}
+	want := []int{10, 11, 12, 15, 14}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("updates = %v, want %v", got, want)
+		}
+	}
+
+	for _, want := range []string{"", "12", "13", "16"} {
+		select {
+		case offset := <-offsets:
+			if offset != want {
+				t.Fatalf("offset = %q, want %q", offset, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout waiting for offset %q", want)
+		}
+	}
+
+	cancel()
+	for {
+		select {
+		case _, ok := <-updates:
+			if !ok {
+				return
+			}
+			t.Fatal("update received after cancel")
+		case <-time.After(5 * time.Second):
+			t.Fatal("channel not closed after cancel")
+		}
+	}
+}
+
+func TestStartPollingStartOffset(t *testing.T) {
+	offsets := make(chan string, 10)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		offsets <- r.FormValue("offset")
+		writeResult(w, []Update{{UpdateID: 7}})
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := bot.StartPolling(ctx, &GetUpdatesOpt{Offset: 7})
+	<-updates
+	if offset := <-offsets; offset != "7" {
+		t.Errorf("first offset = %q, want 7", offset)
+	}
+	if offset := <-offsets; offset != "8" {
+		t.Errorf("second offset = %q, want 8", offset)
+	}
+}
@@ -0,0 +1,100 @@
+package telego
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"syscall"
+	"time"
+)
+
+const (
+	retryMaxAttempts = 3
+	retryMinBackoff  = time.Second
+	retryMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy - settings of repeating failed requests, assign to Bot.Retry to enable. Requests failed
+// because of flood control are repeated after retry_after from the response, requests failed because
+// of network or server (5xx) errors are repeated with an exponential backoff with jitter.
+//
+// MaxAttempts		Optional. Maximum number of attempts including the first one, defaults to 3
+// MinBackoff		Optional. Delay before the first repeat of a failed request, defaults to 1 second
+// MaxBackoff		Optional. Maximum delay between attempts, defaults to 30 seconds
+// MaxRetryAfter	Optional. Requests with a longer retry_after are not repeated, no limit by default
+type RetryPolicy struct {
+	MaxAttempts   int
+	MinBackoff    time.Duration
+	MaxBackoff    time.Duration
+	MaxRetryAfter time.Duration
+}
+
+// delay - time to wait before the next attempt, false if the request must not be repeated
+func (p *RetryPolicy) delay(ctx context.Context, attempt int, err error) (time.Duration, bool) {
+	maxAttempts := p.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = retryMaxAttempts
+	}
+	if attempt >= maxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch {
+		case apiErr.IsTooManyRequests():
+			retryAfter := apiErr.RetryAfter()
+			if retryAfter == 0 {
+				return p.backoff(attempt), true
+			}
+			if p.MaxRetryAfter > 0 && retryAfter > p.MaxRetryAfter {
+				return 0, false
+			}
+			return retryAfter, true
+		case apiErr.Code() >= 500:
+			return p.backoff(attempt), true
+		}
+		return 0, false
+	}
+	if isTransient(err) {
+		return p.backoff(attempt), true
+	}
+	return 0, false
+}
+
+// backoff - exponential delay with jitter for the attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	minBackoff := p.MinBackoff
+	if minBackoff <= 0 {
+		minBackoff = retryMinBackoff
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = retryMaxBackoff
+	}
+	d := minBackoff
+	for i := 1; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// isTransient - network errors that may disappear on the next attempt: timeouts, reset or refused
+// connections and connections closed in the middle of the response. Errors of local files and cancelled
+// or expired contexts are never transient.
+func isTransient(err error) bool {
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,188 @@
+package telego
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+var testRetry = &RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+func TestCreateFileResponseRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		retry     *RetryPolicy
+		failures  int
+		status    int
+		files     map[string]*InputFile
+		wantCalls int32
+		wantErr   bool
+	}{
+		{"success", testRetry, 0, 0, nil, 1, false},
+		{"server errors", testRetry, 2, http.StatusBadGateway, nil, 3, false},
+		{"max attempts", testRetry, 5, http.StatusInternalServerError, nil, 3, true},
+		{"custom max attempts", &RetryPolicy{MaxAttempts: 5, MinBackoff: time.Millisecond}, 4, http.StatusInternalServerError, nil, 5, false},
+		{"bad request", testRetry, 1, http.StatusBadRequest, nil, 1, true},
+		{"forbidden", testRetry, 1, http.StatusForbidden, nil, 1, true},
+		{"no policy", nil, 1, http.StatusInternalServerError, nil, 1, true},
+		{"reader upload", testRetry, 1, http.StatusInternalServerError,
+			map[string]*InputFile{"photo": NewInputFileReader("a.jpg", strings.NewReader("data"))}, 1, true},
+		{"path upload", testRetry, 1, http.StatusInternalServerError,
+			map[string]*InputFile{"photo": NewInputFilePath("retry_test.go")}, 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&calls, 1) <= int32(tt.failures) {
+					writeError(w, tt.status, "error", nil)
+					return
+				}
+				writeResult(w, true)
+			})
+			bot.Retry = tt.retry
+			_, err := bot.createFileResponse("sendPhoto", url.Values{"chat_id": {"1"}}, tt.files)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCreateFileResponseRetryAfter(t *testing.T) {
+	var calls int32
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			writeError(w, http.StatusTooManyRequests, "Too Many Requests: retry after 1", &ResponseParameters{RetryAfter: 1})
+			return
+		}
+		writeResult(w, true)
+	})
+	bot.Retry = testRetry
+	start := time.Now()
+	_, err := bot.createResponse("sendMessage", url.Values{"chat_id": {"1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("repeated after %v, want retry_after 1s", elapsed)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	bot.Retry = &RetryPolicy{MaxRetryAfter: time.Millisecond}
+	_, err = bot.createResponse("sendMessage", url.Values{"chat_id": {"1"}})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsTooManyRequests() || apiErr.RetryAfter() != time.Second {
+		t.Errorf("err = %v, want too many requests with retry after 1s", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d with retry_after over MaxRetryAfter, want 1", calls)
+	}
+}
+
+func TestCreateFileResponseMissingFile(t *testing.T) {
+	var calls int32
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writeResult(w, true)
+	})
+	bot.Retry = testRetry
+	files := map[string]*InputFile{"photo": NewInputFilePath("/nonexistent/photo.jpg")}
+	_, err := bot.createFileResponse("sendPhoto", url.Values{"chat_id": {"1"}}, files)
+	var pathErr *os.PathError
+	if !errors.As(err, &pathErr) {
+		t.Errorf("err = %v, want *os.PathError", err)
+	}
+	if calls != 0 {
+		t.Errorf("calls = %d, want no request", calls)
+	}
+}
+
+func TestCreateFileResponseContext(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusInternalServerError, "error", nil)
+	})
+	bot.Retry = &RetryPolicy{MaxAttempts: 10, MinBackoff: time.Hour}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	done := make(chan error)
+	go func() {
+		_, err := bot.WithContext(ctx).createResponse("sendMessage", url.Values{"chat_id": {"1"}})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("err = nil, want the last error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("retry did not stop when the context was done")
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsTransient(t *testing.T) {
+	urlError := func(err error) error {
+		return &url.Error{Op: "Post", URL: "https://api.telegram.org/botTOKEN/sendMessage", Err: err}
+	}
+	opError := func(err error) error {
+		return &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", err)}
+	}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"timeout", urlError(timeoutError{}), true},
+		{"connection reset", urlError(opError(syscall.ECONNRESET)), true},
+		{"connection refused", urlError(opError(syscall.ECONNREFUSED)), true},
+		{"unexpected EOF", urlError(io.ErrUnexpectedEOF), true},
+		{"canceled", urlError(context.Canceled), false},
+		{"deadline exceeded", urlError(context.DeadlineExceeded), false},
+		{"path error", urlError(&os.PathError{Op: "open", Path: "/nonexistent", Err: os.ErrNotExist}), false},
+		{"certificate", urlError(x509.UnknownAuthorityError{}), false},
+		{"unsupported protocol", urlError(errors.New("unsupported protocol scheme")), false},
+		{"other", errors.New("other"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransient(tt.err); got != tt.want {
+				t.Errorf("isTransient(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, max := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		max *= time.Millisecond
+		for i := 0; i < 20; i++ {
+			d := p.backoff(attempt + 1)
+			if d < max/2 || d > max {
+				t.Fatalf("backoff(%d) = %v, want in [%v, %v]", attempt+1, d, max/2, max)
+			}
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package telego
+
+import "context"
+
+// MessageHandler - function that processes an incoming message or channel post
+type MessageHandler func(ctx context.Context, message *Message)
+
+// InlineQueryHandler - function that processes an incoming inline query
+type InlineQueryHandler func(ctx context.Context, query *InlineQuery)
+
+// ChosenInlineResultHandler - function that processes a chosen inline result
+type ChosenInlineResultHandler func(ctx context.Context, result *ChosenInlineResult)
+
+// CallbackQueryHandler - function that processes an incoming callback query
+type CallbackQueryHandler func(ctx context.Context, query *CallbackQuery)
+
+// ShippingQueryHandler - function that processes an incoming shipping query
+type ShippingQueryHandler func(ctx context.Context, query *ShippingQuery)
+
+// PreCheckoutQueryHandler - function that processes an incoming pre-checkout query
+type PreCheckoutQueryHandler func(ctx context.Context, query *PreCheckoutQuery)
+
+// Router - dispatches incoming updates to the handler registered for the kind of the update. Updates
+// without a registered handler are passed to the fallback handler, if any. Middlewares added with Use
+// wrap every update. HandleUpdate can be used as WebhookHandler.Handler, Serve reads updates from the
+// channel returned by StartPolling.
+type Router struct {
+	message            MessageHandler
+	editedMessage      MessageHandler
+	channelPost        MessageHandler
+	editedChannelPost  MessageHandler
+	inlineQuery        InlineQueryHandler
+	chosenInlineResult ChosenInlineResultHandler
+	callbackQuery      CallbackQueryHandler
+	shippingQuery      ShippingQueryHandler
+	preCheckoutQuery   PreCheckoutQueryHandler
+	fallback           UpdateHandler
+	middlewares        []Middleware
+}
+
+// NewRouter - create new router without handlers
+func NewRouter() *Router {
+	return new(Router)
+}
+
+// OnMessage - set handler for new incoming messages
+func (r *Router) OnMessage(handler MessageHandler) {
+	r.message = handler
+}
+
+// OnEditedMessage - set handler for edited messages
+func (r *Router) OnEditedMessage(handler MessageHandler) {
+	r.editedMessage = handler
+}
+
+// OnChannelPost - set handler for new channel posts
+func (r *Router) OnChannelPost(handler MessageHandler) {
+	r.channelPost = handler
+}
+
+// OnEditedChannelPost - set handler for edited channel posts
+func (r *Router) OnEditedChannelPost(handler MessageHandler) {
+	r.editedChannelPost = handler
+}
+
+// OnInlineQuery - set handler for inline queries
+func (r *Router) OnInlineQuery(handler InlineQueryHandler) {
+	r.inlineQuery = handler
+}
+
+// OnChosenInlineResult - set handler for chosen inline results
+func (r *Router) OnChosenInlineResult(handler ChosenInlineResultHandler) {
+	r.chosenInlineResult = handler
+}
+
+// OnCallbackQuery - set handler for callback queries
+func (r *Router) OnCallbackQuery(handler CallbackQueryHandler) {
+	r.callbackQuery = handler
+}
+
+// OnShippingQuery - set handler for shipping queries
+func (r *Router) OnShippingQuery(handler ShippingQueryHandler) {
+	r.shippingQuery = handler
+}
+
+// OnPreCheckoutQuery - set handler for pre-checkout queries
+func (r *Router) OnPreCheckoutQuery(handler PreCheckoutQueryHandler) {
+	r.preCheckoutQuery = handler
+}
+
+// Fallback - set handler for updates without a registered handler
+func (r *Router) Fallback(handler UpdateHandler) {
+	r.fallback = handler
+}
+
+// Use - add middlewares wrapping the handling of every update, in order of calls
+func (r *Router) Use(middlewares ...Middleware) {
+	r.middlewares = append(r.middlewares, middlewares...)
+}
+
+// HandleUpdate - pass update through the middlewares to the registered handler
+func (r *Router) HandleUpdate(ctx context.Context, update Update) {
+	Chain(r.dispatch, r.middlewares...)(ctx, update)
+}
+
+func (r *Router) dispatch(ctx context.Context, update Update) {
+	switch {
+	case update.Message != nil && r.message != nil:
+		r.message(ctx, update.Message)
+	case update.EditedMessage != nil && r.editedMessage != nil:
+		r.editedMessage(ctx, update.EditedMessage)
+	case update.ChannelPost != nil && r.channelPost != nil:
+		r.channelPost(ctx, update.ChannelPost)
+	case update.EditedChannelPost != nil && r.editedChannelPost != nil:
+		r.editedChannelPost(ctx, update.EditedChannelPost)
+	case update.InlineQuery != nil && r.inlineQuery != nil:
+		r.inlineQuery(ctx, update.InlineQuery)
+	case update.ChosenInlineResult != nil && r.chosenInlineResult != nil:
+		r.chosenInlineResult(ctx, update.ChosenInlineResult)
+	case update.CallbackQuery != nil && r.callbackQuery != nil:
+		r.callbackQuery(ctx, update.CallbackQuery)
+	case update.ShippingQuery != nil && r.shippingQuery != nil:
+		r.shippingQuery(ctx, update.ShippingQuery)
+	case update.PreCheckoutQuery != nil && r.preCheckoutQuery != nil:
+		r.preCheckoutQuery(ctx, update.PreCheckoutQuery)
+	case r.fallback != nil:
+		r.fallback(ctx, update)
+	}
+}
+
+// Serve - handle updates received from the channel one by one until it is closed or ctx is cancelled
+func (r *Router) Serve(ctx context.Context, updates <-chan Update) {
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			r.HandleUpdate(ctx, update)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
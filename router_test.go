@@ -0,0 +1,99 @@
+package telego
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// recordingRouter - router with every handler registered, each recording its name in calls
+func recordingRouter(calls *[]string, fallback bool) *Router {
+	record := func(name string) { *calls = append(*calls, name) }
+	r := NewRouter()
+	r.OnMessage(func(ctx context.Context, message *Message) { record("message") })
+	r.OnEditedMessage(func(ctx context.Context, message *Message) { record("edited message") })
+	r.OnChannelPost(func(ctx context.Context, message *Message) { record("channel post") })
+	r.OnEditedChannelPost(func(ctx context.Context, message *Message) { record("edited channel post") })
+	r.OnInlineQuery(func(ctx context.Context, query *InlineQuery) { record("inline query") })
+	r.OnChosenInlineResult(func(ctx context.Context, result *ChosenInlineResult) { record("chosen inline result") })
+	r.OnCallbackQuery(func(ctx context.Context, query *CallbackQuery) { record("callback query") })
+	r.OnShippingQuery(func(ctx context.Context, query *ShippingQuery) { record("shipping query") })
+	r.OnPreCheckoutQuery(func(ctx context.Context, query *PreCheckoutQuery) { record("pre-checkout query") })
+	if fallback {
+		r.Fallback(func(ctx context.Context, update Update) { record("fallback") })
+	}
+	return r
+}
+
+func TestRouterHandleUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		update   Update
+		router   func(calls *[]string) *Router
+		wantCall string
+	}{
+		{"message", Update{Message: &Message{}}, nil, "message"},
+		{"edited message", Update{EditedMessage: &Message{}}, nil, "edited message"},
+		{"channel post", Update{ChannelPost: &Message{}}, nil, "channel post"},
+		{"edited channel post", Update{EditedChannelPost: &Message{}}, nil, "edited channel post"},
+		{"inline query", Update{InlineQuery: &InlineQuery{}}, nil, "inline query"},
+		{"chosen inline result", Update{ChosenInlineResult: &ChosenInlineResult{}}, nil, "chosen inline result"},
+		{"callback query", Update{CallbackQuery: &CallbackQuery{}}, nil, "callback query"},
+		{"shipping query", Update{ShippingQuery: &ShippingQuery{}}, nil, "shipping query"},
+		{"pre-checkout query", Update{PreCheckoutQuery: &PreCheckoutQuery{}}, nil, "pre-checkout query"},
+		{"message before callback query", Update{Message: &Message{}, CallbackQuery: &CallbackQuery{}}, nil, "message"},
+		{"empty update", Update{UpdateID: 1}, nil, "fallback"},
+		{"no handler", Update{Message: &Message{}}, func(calls *[]string) *Router {
+			r := NewRouter()
+			r.OnCallbackQuery(func(ctx context.Context, query *CallbackQuery) { *calls = append(*calls, "callback query") })
+			r.Fallback(func(ctx context.Context, update Update) { *calls = append(*calls, "fallback") })
+			return r
+		}, "fallback"},
+		{"no handler without fallback", Update{Message: &Message{}}, func(calls *[]string) *Router {
+			return NewRouter()
+		}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			r := recordingRouter(&calls, true)
+			if tt.router != nil {
+				r = tt.router(&calls)
+			}
+			r.HandleUpdate(context.Background(), tt.update)
+			want := []string{tt.wantCall}
+			if tt.wantCall == "" {
+				want = nil
+			}
+			if len(calls) != len(want) || (len(want) == 1 && calls[0] != want[0]) {
+				t.Errorf("calls = %q, want %q", calls, want)
+			}
+		})
+	}
+}
+
+func TestRouterServe(t *testing.T) {
+	var calls []string
+	r := recordingRouter(&calls, false)
+	updates := make(chan Update, 2)
+	updates <- Update{Message: &Message{}}
+	updates <- Update{CallbackQuery: &CallbackQuery{}}
+	close(updates)
+	r.Serve(context.Background(), updates)
+	if len(calls) != 2 || calls[0] != "message" || calls[1] != "callback query" {
+		t.Errorf("calls = %q, want updates in order", calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Serve(ctx, make(chan Update))
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return when the context was cancelled")
+	}
+}
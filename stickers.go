@@ -1,26 +1,314 @@
 package telego
 
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
 // Sticker - This object represents a sticker.
 // file_id	    String		Unique identifier for this file
 // width	    Integer		Sticker width
 // height	    Integer		Sticker height
 // thumb	    PhotoSize	Optional. Sticker thumbnail in .webp or .jpg format
 // emoji	    String		Optional. Emoji associated with the sticker
+// set_name		String		Optional. Name of the sticker set to which the sticker belongs
+// mask_position	MaskPosition	Optional. For mask stickers, the position where the mask should be placed
 // file_size	Integer		Optional. File size
 type Sticker struct {
-	FileID   string     `json:"file_id"`
-	Width    int        `json:"width"`
-	Height   int        `json:"height"`
-	Thumb    *PhotoSize `json:"thumb,omitempty"`
-	Emoji    string     `json:"emoji,omitempty"`
-	FileSize int        `json:"file_size,omitempty"`
-}
-
-// sendSticker
-// Use this method to send .webp stickers. On success, the sent Message is returned.
-//
-// chat_id	Integer or String	Yes	Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-// sticker	InputFile or String	Yes	Sticker to send. Pass a file_id as String to send a file that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get a .webp file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files »
-// disable_notification	Boolean	Optional	Sends the message silently. iOS users will not receive a notification, Android users will receive a notification with no sound.
-// reply_to_message_id	Integer	Optional	If the message is a reply, ID of the original message
-// reply_markup	InlineKeyboardMarkup or ReplyKeyboardMarkup or ReplyKeyboardRemove or ForceReply	Optional	Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	FileID       string        `json:"file_id"`
+	Width        int           `json:"width"`
+	Height       int           `json:"height"`
+	Thumb        *PhotoSize    `json:"thumb,omitempty"`
+	Emoji        string        `json:"emoji,omitempty"`
+	SetName      string        `json:"set_name,omitempty"`
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+	FileSize     int           `json:"file_size,omitempty"`
+}
+
+// StickerSet - This object represents a sticker set.
+//
+// name				String				Sticker set name
+// title			String				Sticker set title
+// contains_masks	Boolean				True, if the sticker set contains masks
+// stickers			Array of Sticker	List of all set stickers
+type StickerSet struct {
+	Name          string     `json:"name"`
+	Title         string     `json:"title"`
+	ContainsMasks bool       `json:"contains_masks"`
+	Stickers      []*Sticker `json:"stickers"`
+}
+
+// MaskPosition - This object describes the position on faces where a mask should be placed by default.
+//
+// point	String	The part of the face relative to which the mask should be placed. One of "forehead", "eyes",
+//					"mouth", or "chin".
+// x_shift	Float	Shift by X-axis measured in widths of the mask scaled to the face size, from left to right.
+//					For example, choosing -1.0 will place mask just to the left of the default mask position.
+// y_shift	Float	Shift by Y-axis measured in heights of the mask scaled to the face size, from top to bottom.
+//					For example, 1.0 will place the mask just below the default mask position.
+// scale	Float	Mask scaling coefficient. For example, 2.0 means double size.
+type MaskPosition struct {
+	Point  string  `json:"point"`
+	XShift float64 `json:"x_shift"`
+	YShift float64 `json:"y_shift"`
+	Scale  float64 `json:"scale"`
+}
+
+// Mask points
+const (
+	MaskPointForehead = "forehead"
+	MaskPointEyes     = "eyes"
+	MaskPointMouth    = "mouth"
+	MaskPointChin     = "chin"
+)
+
+// SendSticker - "sendSticker" Use this method to send .webp stickers. On success, the sent Message is
+// returned.
+//
+// chat_id		Integer or	Yes			Unique identifier for the target chat or username of the target channel
+//				String					(in the format @channelusername)
+// sticker		InputFile	Yes			Sticker to send. Pass a file_id as String to send a file that exists on
+//				or String				the Telegram servers (recommended), pass an HTTP URL as a String for
+//										Telegram to get a .webp file from the Internet, or upload a new one
+//										using multipart/form-data.
+// disable_notification		Optional	Sends the message silently. iOS users will not receive a notification,
+//				Boolean					Android users will receive a notification with no sound.
+// reply_to_message_id		Optional	If the message is a reply, ID of the original message
+//				Integer
+// reply_markup				Optional	Additional interface options. A JSON-serialized object for an inline
+//				InlineKeyboardMarkup	keyboard, custom reply keyboard, instructions to remove reply keyboard
+//				or ReplyKeyboardMarkup	or to force a reply from the user.
+//				or ReplyKeyboardRemove
+//				or ForceReply
+func (bot *Bot) SendSticker(opt *SendStickerOpt) (Message, error) {
+	values := url.Values{}
+	if opt.ChatID == "" || opt.Sticker.empty() {
+		return Message{}, ErrMissingParam
+	}
+	values.Set("chat_id", opt.ChatID)
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "sticker", opt.Sticker)
+	if opt.DisableNotification {
+		values.Set("disable_notification", "true")
+	}
+	if opt.ReplyToMessageID > 0 {
+		values.Set("reply_to_message_id", strconv.Itoa(opt.ReplyToMessageID))
+	}
+	if opt.ReplyMarkup != nil {
+		err := setJSONValue(values, "reply_markup", opt.ReplyMarkup)
+		if err != nil {
+			errLog("SendSticker setJSONValue", err)
+			return Message{}, err
+		}
+	}
+	r, err := bot.createFileResponse("sendSticker", values, files)
+	if err != nil {
+		errLog("SendSticker createResponse", err)
+		return Message{}, err
+	}
+	var message Message
+	err = json.Unmarshal(r.Result, &message)
+	if err != nil {
+		errLog("SendSticker Unmarshal", err)
+	}
+	return message, err
+}
+
+// GetStickerSet - "getStickerSet" Use this method to get a sticker set. On success, a StickerSet object is
+// returned.
+//
+// name	String	Yes	Name of the sticker set
+func (bot *Bot) GetStickerSet(name string) (StickerSet, error) {
+	if name == "" {
+		return StickerSet{}, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("name", name)
+	r, err := bot.createResponse("getStickerSet", values)
+	if err != nil {
+		errLog("GetStickerSet createResponse", err)
+		return StickerSet{}, err
+	}
+	var set StickerSet
+	err = json.Unmarshal(r.Result, &set)
+	if err != nil {
+		errLog("GetStickerSet Unmarshal", err)
+	}
+	return set, err
+}
+
+// UploadStickerFile - "uploadStickerFile" Use this method to upload a .png file with a sticker for later use
+// in createNewStickerSet and addStickerToSet methods (can be used multiple times). Returns the uploaded File
+// on success.
+//
+// user_id		Integer		Yes	User identifier of sticker file owner
+// png_sticker	InputFile	Yes	Png image with the sticker, must be up to 512 kilobytes in size, dimensions must
+//								not exceed 512px, and either width or height must be exactly 512px. Must be
+//								uploaded from a path or a reader.
+func (bot *Bot) UploadStickerFile(userID int, pngSticker *InputFile) (File, error) {
+	if userID == 0 || pngSticker.empty() || !pngSticker.isUpload() {
+		return File{}, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("user_id", strconv.Itoa(userID))
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "png_sticker", pngSticker)
+	r, err := bot.createFileResponse("uploadStickerFile", values, files)
+	if err != nil {
+		errLog("UploadStickerFile createResponse", err)
+		return File{}, err
+	}
+	var file File
+	err = json.Unmarshal(r.Result, &file)
+	if err != nil {
+		errLog("UploadStickerFile Unmarshal", err)
+	}
+	return file, err
+}
+
+// CreateNewStickerSet - "createNewStickerSet" Use this method to create new sticker set owned by a user. The
+// bot will be able to edit the created sticker set. Returns True on success.
+//
+// user_id			Integer		Yes			User identifier of created sticker set owner
+// name				String		Yes			Short name of sticker set, to be used in t.me/addstickers/ URLs
+//											(e.g., animals). Can contain only english letters, digits and
+//											underscores. Must begin with a letter, can't contain consecutive
+//											underscores and must end in "_by_<bot username>". <bot_username> is
+//											case insensitive. 1-64 characters.
+// title			String		Yes			Sticker set title, 1-64 characters
+// png_sticker		InputFile	Yes			Png image with the sticker, must be up to 512 kilobytes in size,
+//					or String				dimensions must not exceed 512px, and either width or height must be
+//											exactly 512px. Pass a file_id as a String to send a file that already
+//											exists on the Telegram servers, pass an HTTP URL as a String for
+//											Telegram to get a file from the Internet, or upload a new one using
+//											multipart/form-data.
+// emojis			String		Yes			One or more emoji corresponding to the sticker
+// contains_masks	Boolean		Optional	Pass True, if a set of mask stickers should be created
+// mask_position	MaskPosition	Optional	A JSON-serialized object for position where the mask should be
+//											placed on faces
+func (bot *Bot) CreateNewStickerSet(opt *CreateNewStickerSetOpt) (bool, error) {
+	if opt.UserID == 0 || opt.Name == "" || opt.Title == "" || opt.PngSticker.empty() || opt.Emojis == "" {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("user_id", strconv.Itoa(opt.UserID))
+	values.Set("name", opt.Name)
+	values.Set("title", opt.Title)
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "png_sticker", opt.PngSticker)
+	values.Set("emojis", opt.Emojis)
+	if opt.ContainsMasks {
+		values.Set("contains_masks", "true")
+	}
+	if opt.MaskPosition != nil {
+		err := setJSONValue(values, "mask_position", opt.MaskPosition)
+		if err != nil {
+			errLog("CreateNewStickerSet setJSONValue", err)
+			return false, err
+		}
+	}
+	r, err := bot.createFileResponse("createNewStickerSet", values, files)
+	if err != nil {
+		errLog("CreateNewStickerSet createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("CreateNewStickerSet Unmarshal", err)
+	}
+	return result, err
+}
+
+// AddStickerToSet - "addStickerToSet" Use this method to add a new sticker to a set created by the bot.
+// Returns True on success.
+//
+// user_id			Integer		Yes			User identifier of sticker set owner
+// name				String		Yes			Sticker set name
+// png_sticker		InputFile	Yes			Png image with the sticker, must be up to 512 kilobytes in size,
+//					or String				dimensions must not exceed 512px, and either width or height must be
+//											exactly 512px. Pass a file_id as a String to send a file that already
+//											exists on the Telegram servers, pass an HTTP URL as a String for
+//											Telegram to get a file from the Internet, or upload a new one using
+//											multipart/form-data.
+// emojis			String		Yes			One or more emoji corresponding to the sticker
+// mask_position	MaskPosition	Optional	A JSON-serialized object for position where the mask should be
+//											placed on faces
+func (bot *Bot) AddStickerToSet(opt *AddStickerToSetOpt) (bool, error) {
+	if opt.UserID == 0 || opt.Name == "" || opt.PngSticker.empty() || opt.Emojis == "" {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("user_id", strconv.Itoa(opt.UserID))
+	values.Set("name", opt.Name)
+	files := make(map[string]*InputFile)
+	setInputFile(values, files, "png_sticker", opt.PngSticker)
+	values.Set("emojis", opt.Emojis)
+	if opt.MaskPosition != nil {
+		err := setJSONValue(values, "mask_position", opt.MaskPosition)
+		if err != nil {
+			errLog("AddStickerToSet setJSONValue", err)
+			return false, err
+		}
+	}
+	r, err := bot.createFileResponse("addStickerToSet", values, files)
+	if err != nil {
+		errLog("AddStickerToSet createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("AddStickerToSet Unmarshal", err)
+	}
+	return result, err
+}
+
+// SetStickerPositionInSet - "setStickerPositionInSet" Use this method to move a sticker in a set created by
+// the bot to a specific position. Returns True on success.
+//
+// sticker		String	Yes	File identifier of the sticker
+// position		Integer	Yes	New sticker position in the set, zero-based
+func (bot *Bot) SetStickerPositionInSet(sticker string, position int) (bool, error) {
+	if sticker == "" || position < 0 {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("sticker", sticker)
+	values.Set("position", strconv.Itoa(position))
+	r, err := bot.createResponse("setStickerPositionInSet", values)
+	if err != nil {
+		errLog("SetStickerPositionInSet createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("SetStickerPositionInSet Unmarshal", err)
+	}
+	return result, err
+}
+
+// DeleteStickerFromSet - "deleteStickerFromSet" Use this method to delete a sticker from a set created by the
+// bot. Returns True on success.
+//
+// sticker	String	Yes	File identifier of the sticker
+func (bot *Bot) DeleteStickerFromSet(sticker string) (bool, error) {
+	if sticker == "" {
+		return false, ErrMissingParam
+	}
+	values := url.Values{}
+	values.Set("sticker", sticker)
+	r, err := bot.createResponse("deleteStickerFromSet", values)
+	if err != nil {
+		errLog("DeleteStickerFromSet createResponse", err)
+		return false, err
+	}
+	var result bool
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		errLog("DeleteStickerFromSet Unmarshal", err)
+	}
+	return result, err
+}
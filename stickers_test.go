@@ -0,0 +1,42 @@
+package telego
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUploadStickerFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  int
+		sticker *InputFile
+		wantErr error
+	}{
+		{"file id", 1, NewInputFileID("CAAD"), ErrMissingParam},
+		{"url", 1, NewInputFileURL("https://example.com/a.png"), ErrMissingParam},
+		{"nil file", 1, nil, ErrMissingParam},
+		{"no user", 0, NewInputFileReader("a.png", strings.NewReader("png")), ErrMissingParam},
+		{"upload", 1, NewInputFileReader("a.png", strings.NewReader("png")), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, values, files := uploadStub(t, File{FileID: "uploaded"})
+			file, err := bot.UploadStickerFile(tt.userID, tt.sticker)
+			if err != tt.wantErr {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(values) != 0 {
+					t.Error("request sent for an invalid sticker")
+				}
+				return
+			}
+			if file.FileID != "uploaded" || values["user_id"] != "1" {
+				t.Errorf("file = %+v, values = %v", file, values)
+			}
+			if files["png_sticker"] != (uploadedFile{"a.png", "png"}) {
+				t.Errorf("uploaded file = %+v", files["png_sticker"])
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package telego
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
-const urlAPI = "https://api.telegram.org/bot"
+const urlServer = "https://api.telegram.org"
 
 var (
 	botDebugLog bool
@@ -14,6 +17,17 @@ type Bot struct {
 	Token  string
 	Client *http.Client
 	Self   *User
+	// Server - Bot API server address, https://api.telegram.org if empty
+	Server string
+	// Retry - policy of repeating failed requests, failed requests are not repeated if nil
+	Retry *RetryPolicy
+	// Limiter - scheduler of outgoing messages, messages are sent without delays if nil
+	Limiter *Limiter
+	// OnChatMigrated - called when a request is resent to the supergroup the group was migrated to and,
+	// with the ChatMigrations middleware, when a migration service message is received
+	OnChatMigrated func(fromChatID, toChatID int)
+
+	ctx context.Context
 }
 
 // NewBot - create new bot
@@ -35,3 +49,39 @@ func NewBot(token string, debugLog bool, errorLog bool) (*Bot, error) {
 
 	return bot, nil
 }
+
+// WithContext - returns a shallow copy of bot with its context changed to ctx. Requests to the Bot API
+// made with the returned bot use ctx, so they are cancelled when ctx is done or its deadline exceeded.
+//
+//	message, err := bot.WithContext(ctx).SendMessage(opt)
+func (bot *Bot) WithContext(ctx context.Context) *Bot {
+	if ctx == nil {
+		panic("telego: nil context")
+	}
+	b := *bot
+	b.ctx = ctx
+	return &b
+}
+
+// Context - returns the bot context, context.Background if it was not set with WithContext
+func (bot *Bot) Context() context.Context {
+	if bot.ctx != nil {
+		return bot.ctx
+	}
+	return context.Background()
+}
+
+func (bot *Bot) server() string {
+	if bot.Server == "" {
+		return urlServer
+	}
+	return bot.Server
+}
+
+func (bot *Bot) methodURL(method string) string {
+	return bot.server() + "/bot" + bot.Token + "/" + method
+}
+
+func (bot *Bot) fileURL(filePath string) string {
+	return bot.server() + "/file/bot" + bot.Token + "/" + filePath
+}
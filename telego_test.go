@@ -0,0 +1,50 @@
+package telego
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"testing"
+)
+
+// newTestBot - bot sending requests to a stub of the Bot API served by handler
+func newTestBot(t *testing.T, handler http.HandlerFunc) *Bot {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &Bot{Token: "TOKEN", Client: server.Client(), Server: server.URL}
+}
+
+// apiMethod - Bot API method name of the request
+func apiMethod(r *http.Request) string {
+	return path.Base(r.URL.Path)
+}
+
+// formStub - stub recording the method and form of every request and answering with result
+func formStub(t *testing.T, result interface{}) (*Bot, *[]url.Values) {
+	var forms []url.Values
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		r.Form.Set("method", apiMethod(r))
+		forms = append(forms, r.Form)
+		writeResult(w, result)
+	})
+	return bot, &forms
+}
+
+// writeResult - successful response with result
+func writeResult(w http.ResponseWriter, result interface{}) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		panic(err)
+	}
+	json.NewEncoder(w).Encode(Response{Ok: true, Result: data})
+}
+
+// writeError - unsuccessful response with the status code
+func writeError(w http.ResponseWriter, status int, description string, parameters *ResponseParameters) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(Response{ErrorCode: status, Description: description, Parameters: parameters})
+}
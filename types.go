@@ -322,6 +322,17 @@ type File struct {
 	FilePath string `json:"file_path,omitempty"`
 }
 
+// ReplyMarkup - Additional interface options of a sent message. Implemented by InlineKeyboardMarkup,
+// ReplyKeyboardMarkup, ReplyKeyboardRemove and ForceReply.
+type ReplyMarkup interface {
+	replyMarkup()
+}
+
+func (InlineKeyboardMarkup) replyMarkup() {}
+func (ReplyKeyboardMarkup) replyMarkup()  {}
+func (ReplyKeyboardRemove) replyMarkup()  {}
+func (ForceReply) replyMarkup()           {}
+
 // ReplyKeyboardMarkup - This object represents a custom keyboard with reply options
 // (see Introduction to bots for details and examples).
 //
@@ -463,6 +474,8 @@ type CallbackQuery struct {
 	ChatInstance    string   `json:"chat_instance,omitempty"`
 	Data            string   `json:"data,omitempty"`
 	GameShortName   string   `json:"game_short_name,omitempty"`
+
+	answered int32
 }
 
 // ForceReply - Upon receiving a message with this object, Telegram clients will display a reply
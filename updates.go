@@ -1,6 +1,7 @@
 package telego
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -37,6 +38,9 @@ type Update struct {
 	PreCheckoutQuery   *PreCheckoutQuery   `json:"pre_checkout_query,omitempty"`
 }
 
+// UpdateHandler - function that processes an incoming update
+type UpdateHandler func(ctx context.Context, update Update)
+
 // GetUpdates - "getUpdates" Use this method to receive incoming updates using long polling (wiki). An Array of
 // Update objects is returned.
 //
@@ -112,6 +116,8 @@ func (t *Bot) GetUpdates(opt *GetUpdatesOpt) ([]Update, error) {
 //											webhook for update delivery, 1-100. Defaults to 40. Use lower values
 //											to limit the load on your bot‘s server, and higher values to increase
 //											your bot’s throughput.
+// secret_token		String		Optional	A secret token to be sent in the X-Telegram-Bot-Api-Secret-Token
+//											header in every webhook request, 1-256 characters.
 // allowed_updates	Array		Optional	List the types of updates you want your bot to receive. For example,
 //					of String				specify [“message”, “edited_channel_post”, “callback_query”] to only
 //											receive updates of these types. See Update for a complete list of
@@ -127,16 +133,20 @@ func (t *Bot) SetWebhook(opt *SetWebhookOpt) error {
 	}
 	values := url.Values{}
 	values.Set("url", opt.URL)
-	if opt.Certificate != "" {
-		values.Set("certificate", opt.Certificate)
+	files := make(map[string]*InputFile)
+	if !opt.Certificate.empty() {
+		setInputFile(values, files, "certificate", opt.Certificate)
 	}
 	if opt.MaxConnections > 0 {
 		values.Set("max_connections", strconv.Itoa(opt.MaxConnections))
 	}
+	if opt.SecretToken != "" {
+		values.Set("secret_token", opt.SecretToken)
+	}
 	if len(opt.AllowedUpdates) > 0 {
 		values["allowed_updates"] = opt.AllowedUpdates
 	}
-	r, err := t.createResponse("setWebhook", values)
+	r, err := t.createFileResponse("setWebhook", values, files)
 	if err != nil {
 		errLog("SetWebhook createResponse", err)
 		return err
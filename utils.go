@@ -1,44 +1,261 @@
 package telego
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
 	"log"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Errors
 var (
 	ErrMissingParam  = errors.New("Missing param")
 	ErrForbiddenHTTP = errors.New("Forbidden http")
+	ErrFileTooBig    = errors.New("File too big")
+	ErrInvalidOffset = errors.New("Invalid offset")
+
+	ErrUnknownGame      = errors.New("Unknown game")
+	ErrInvalidSignature = errors.New("Invalid signature")
+	ErrExpiredSignature = errors.New("Expired signature")
 )
 
+// APIError - error returned when the Bot API request was unsuccessful. Use errors.As to get it from
+// the error returned by a method. errors.Is(err, ErrForbiddenHTTP) reports whether the bot has no
+// access to the chat.
+//
+// Method		Bot API method name
+// StatusCode	HTTP status code of the response
+// ErrorCode	Error code from the response, usually equal to StatusCode
+// Description	Human-readable description of the error
+// Parameters	Optional. Information about why the request was unsuccessful (retry_after,
+//				migrate_to_chat_id)
+type APIError struct {
+	Method      string
+	StatusCode  int
+	ErrorCode   int
+	Description string
+	Parameters  *ResponseParameters
+}
+
+func (e *APIError) Error() string {
+	return e.Method + ": " + e.Description
+}
+
+// Is - reports whether the error matches ErrForbiddenHTTP
+func (e *APIError) Is(target error) bool {
+	return target == ErrForbiddenHTTP && e.IsForbidden()
+}
+
+// Code - error code of the response, the HTTP status code if the response has no error_code
+func (e *APIError) Code() int {
+	if e.ErrorCode != 0 {
+		return e.ErrorCode
+	}
+	return e.StatusCode
+}
+
+// IsForbidden - the bot was blocked by the user, kicked from the chat or has no rights for the action
+func (e *APIError) IsForbidden() bool {
+	return e.Code() == http.StatusForbidden
+}
+
+// IsTooManyRequests - flood control was exceeded, the request can be repeated after RetryAfter
+func (e *APIError) IsTooManyRequests() bool {
+	return e.Code() == http.StatusTooManyRequests
+}
+
+// IsChatMigrated - the group has been migrated to a supergroup with MigrateToChatID
+func (e *APIError) IsChatMigrated() bool {
+	return e.MigrateToChatID() != 0
+}
+
+// RetryAfter - time to wait before the request can be repeated, 0 if not specified
+func (e *APIError) RetryAfter() time.Duration {
+	if e.Parameters == nil {
+		return 0
+	}
+	return time.Duration(e.Parameters.RetryAfter) * time.Second
+}
+
+// MigrateToChatID - identifier of the supergroup the group has been migrated to, 0 if not specified
+func (e *APIError) MigrateToChatID() int {
+	if e.Parameters == nil {
+		return 0
+	}
+	return e.Parameters.MigrateToChatID
+}
+
 func (bot *Bot) createResponse(method string, values url.Values) (Response, error) {
-	fullURL := urlAPI + bot.Token + "/" + method
-	resp, err := bot.Client.PostForm(fullURL, values)
+	return bot.createFileResponse(method, values, nil)
+}
+
+// createFileResponse - call the Bot API method, files are uploaded with multipart/form-data. Limits of
+// bot.Limiter are respected, a request to a group that was migrated to a supergroup is resent to the
+// supergroup, failed requests are repeated according to bot.Retry. Requests uploading files from an
+// io.Reader are never repeated, because the reader can be read only once.
+func (bot *Bot) createFileResponse(method string, values url.Values, files map[string]*InputFile) (Response, error) {
+	replayable := true
+	for _, file := range files {
+		if file.Reader != nil {
+			replayable = false
+		}
+	}
+	migrated := false
+	for attempt := 1; ; {
+		if bot.Limiter != nil && isLimitedMethod(method) {
+			err := bot.Limiter.Wait(bot.Context(), values.Get("chat_id"))
+			if err != nil {
+				errLog("createResponse Limiter.Wait", err)
+				return Response{}, err
+			}
+		}
+		response, err := bot.doRequest(method, values, files)
+		if err == nil || !replayable {
+			return response, err
+		}
+		var apiErr *APIError
+		if !migrated && errors.As(err, &apiErr) && apiErr.IsChatMigrated() && values.Get("chat_id") != "" {
+			debugLog("debugLog " + method + " resend after " + err.Error())
+			values = bot.migrateChat(values, apiErr.MigrateToChatID())
+			migrated = true
+			continue
+		}
+		if bot.Retry == nil {
+			return response, err
+		}
+		ctx := bot.Context()
+		delay, ok := bot.Retry.delay(ctx, attempt, err)
+		if !ok {
+			return response, err
+		}
+		debugLog("debugLog " + method + " retry in " + delay.String() + " after " + err.Error())
+		if !sleepContext(ctx, delay) {
+			return response, err
+		}
+		attempt++
+	}
+}
+
+// newRequest - create request with url-encoded values or, if there are files to upload, with a
+// multipart/form-data body streamed from the files. Local files are opened before the request is
+// created, so a missing file is reported without sending anything.
+func (bot *Bot) newRequest(method string, values url.Values, files map[string]*InputFile) (*http.Request, error) {
+	if len(files) == 0 {
+		req, err := http.NewRequest(http.MethodPost, bot.methodURL(method), strings.NewReader(values.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req.WithContext(bot.Context()), nil
+	}
+	readers := make(map[string]io.Reader, len(files))
+	var opened []*os.File
+	for field, file := range files {
+		if file.Reader != nil {
+			readers[field] = file.Reader
+			continue
+		}
+		f, err := os.Open(file.Path)
+		if err != nil {
+			closeFiles(opened)
+			return nil, err
+		}
+		opened = append(opened, f)
+		readers[field] = f
+	}
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	req, err := http.NewRequest(http.MethodPost, bot.methodURL(method), pr)
 	if err != nil {
-		errLog("createResponse bot.Client.PostForm", err)
+		closeFiles(opened)
+		return nil, err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	go func() {
+		defer closeFiles(opened)
+		err := writeMultipart(mw, values, files, readers)
+		if err != nil {
+			errLog("newRequest writeMultipart", err)
+		}
+		pw.CloseWithError(err)
+	}()
+	return req.WithContext(bot.Context()), nil
+}
+
+func writeMultipart(mw *multipart.Writer, values url.Values, files map[string]*InputFile, readers map[string]io.Reader) error {
+	for key, value := range values {
+		for _, v := range value {
+			err := mw.WriteField(key, v)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for field, file := range files {
+		err := file.write(mw, field, readers[field])
+		if err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
+
+// closeFiles - close the local files opened for upload
+func closeFiles(files []*os.File) {
+	for _, file := range files {
+		err := file.Close()
+		if err != nil {
+			errLog("closeFiles file.Close", err)
+		}
+	}
+}
+
+// migrateChat - copy of values with chat_id changed to the supergroup the group was migrated to
+func (bot *Bot) migrateChat(values url.Values, toChatID int) url.Values {
+	migrated := make(url.Values, len(values))
+	for key, value := range values {
+		migrated[key] = value
+	}
+	migrated.Set("chat_id", strconv.Itoa(toChatID))
+	if bot.OnChatMigrated != nil {
+		fromChatID, _ := strconv.Atoi(values.Get("chat_id"))
+		bot.OnChatMigrated(fromChatID, toChatID)
+	}
+	return migrated
+}
+
+// doRequest - make a single request to the Bot API method
+func (bot *Bot) doRequest(method string, values url.Values, files map[string]*InputFile) (Response, error) {
+	req, err := bot.newRequest(method, values, files)
+	if err != nil {
+		errLog("doRequest bot.newRequest", err)
+		return Response{}, err
+	}
+	resp, err := bot.Client.Do(req)
+	if err != nil {
+		errLog("doRequest bot.Client.Do", err)
 		return Response{}, err
 	}
 
 	defer func() {
 		err = resp.Body.Close()
 		if err != nil {
-			errLog("createResponse resp.Body.Close", err)
+			errLog("doRequest resp.Body.Close", err)
 		}
 	}()
 
-	if resp.StatusCode == http.StatusForbidden {
-		err = ErrForbiddenHTTP
-		errLog("createResponse http.StatusForbidden", err)
-		return Response{}, err
-	}
-
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		errLog("createResponse ioutil.ReadAll", err)
+		errLog("doRequest ioutil.ReadAll", err)
 		return Response{}, err
 	}
 
@@ -47,20 +264,51 @@ func (bot *Bot) createResponse(method string, values url.Values) (Response, erro
 	var response Response
 
 	err = json.Unmarshal(body, &response)
-	if err != nil {
-		errLog("createResponse json.Unmarshal", err)
+	if err != nil && resp.StatusCode == http.StatusOK {
+		errLog("doRequest json.Unmarshal", err)
 		return Response{}, err
 	}
 
-	if !response.Ok {
-		err = errors.New(response.Description)
-		errLog("createResponse !response.Ok", err)
-		return response, err
+	if err != nil || !response.Ok {
+		apiErr := &APIError{
+			Method:      method,
+			StatusCode:  resp.StatusCode,
+			ErrorCode:   response.ErrorCode,
+			Description: response.Description,
+			Parameters:  response.Parameters,
+		}
+		if apiErr.Description == "" {
+			apiErr.Description = http.StatusText(resp.StatusCode)
+		}
+		errLog("doRequest !response.Ok", apiErr)
+		return response, apiErr
 	}
 
 	return response, nil
 }
 
+// setJSONValue - set JSON-serialized v as the value of key
+func setJSONValue(values url.Values, key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	values.Set(key, string(data))
+	return nil
+}
+
+// sleepContext - wait for d, returns false if ctx was cancelled before
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 func errLog(s string, err error) {
 	if botErrorLog {
 		log.Println("Error in ", s, err)
@@ -72,18 +320,3 @@ func debugLog(s string) {
 		log.Println(s)
 	}
 }
-
-// func rootHandler(w http.ResponseWriter, r *http.Request) {
-// 	body, err := ioutil.ReadAll(r.Body)
-// 	if err != nil {
-// 		errLog("rootHandler ReadAll", err)
-// 		return
-// 	}
-// 	defer func() {
-// 		err = r.Body.Close()
-// 		if err != nil {
-// 			errLog("createResponse resp.Body.Close", err)
-// 		}
-// 	}()
-// 	log.Println(string(body))
-// }
@@ -0,0 +1,91 @@
+package telego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestCreateResponseChatMigrated(t *testing.T) {
+	var chatIDs []string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		chatID := r.FormValue("chat_id")
+		chatIDs = append(chatIDs, chatID)
+		if chatID == "-100" || chatID == "-300" {
+			writeError(w, http.StatusBadRequest, "Bad Request: group chat was upgraded to a supergroup chat",
+				&ResponseParameters{MigrateToChatID: -200})
+			return
+		}
+		writeResult(w, true)
+	})
+	var migrations [][2]int
+	bot.OnChatMigrated = func(fromChatID, toChatID int) {
+		migrations = append(migrations, [2]int{fromChatID, toChatID})
+	}
+
+	values := url.Values{"chat_id": {"-100"}, "text": {"hi"}}
+	_, err := bot.createResponse("sendMessage", values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chatIDs) != 2 || chatIDs[1] != "-200" {
+		t.Errorf("chat_id of requests = %q, want [-100 -200]", chatIDs)
+	}
+	if values.Get("chat_id") != "-100" {
+		t.Errorf("values of the caller changed to chat_id %s", values.Get("chat_id"))
+	}
+	if len(migrations) != 1 || migrations[0] != [2]int{-100, -200} {
+		t.Errorf("migrations = %v, want [[-100 -200]]", migrations)
+	}
+
+	// the supergroup is migrated again, the request is resent only once
+	bot = newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusBadRequest, "Bad Request: group chat was upgraded to a supergroup chat",
+			&ResponseParameters{MigrateToChatID: -300})
+	})
+	_, err = bot.createResponse("sendMessage", url.Values{"chat_id": {"-100"}})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.MigrateToChatID() != -300 {
+		t.Errorf("err = %v, want chat migrated error", err)
+	}
+}
+
+func TestChatMigrations(t *testing.T) {
+	bot := &Bot{}
+	var migrations [][2]int
+	bot.OnChatMigrated = func(fromChatID, toChatID int) {
+		migrations = append(migrations, [2]int{fromChatID, toChatID})
+	}
+	nextCalls := 0
+	handler := Chain(func(ctx context.Context, update Update) { nextCalls++ }, bot.ChatMigrations())
+	handler(context.Background(), Update{Message: &Message{Chat: &Chat{ID: -100}, MigrateToChatID: -200}})
+	handler(context.Background(), Update{Message: &Message{Chat: &Chat{ID: -200}, MigrateFromChatID: -100}})
+	handler(context.Background(), Update{Message: &Message{Chat: &Chat{ID: -200}, Text: "hi"}})
+	handler(context.Background(), Update{CallbackQuery: &CallbackQuery{}})
+	want := [][2]int{{-100, -200}, {-100, -200}}
+	if len(migrations) != len(want) || migrations[0] != want[0] || migrations[1] != want[1] {
+		t.Errorf("migrations = %v, want %v", migrations, want)
+	}
+	if nextCalls != 4 {
+		t.Errorf("next called %d times, want 4", nextCalls)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusForbidden, "Forbidden: bot was blocked by the user", nil)
+	})
+	_, err := bot.createResponse("sendMessage", url.Values{"chat_id": {"1"}})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if apiErr.Method != "sendMessage" || apiErr.Code() != http.StatusForbidden || !apiErr.IsForbidden() {
+		t.Errorf("APIError = %+v", apiErr)
+	}
+	if !errors.Is(err, ErrForbiddenHTTP) {
+		t.Error("errors.Is(err, ErrForbiddenHTTP) = false")
+	}
+}
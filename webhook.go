@@ -0,0 +1,124 @@
+package telego
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+const (
+	webhookSecretHeader = "X-Telegram-Bot-Api-Secret-Token"
+	webhookQueueSize    = 100
+	webhookMaxBodySize  = 1 << 20
+)
+
+var errWebhookUnavailable = errors.New("Queue is full or the handler is stopped")
+
+// WebhookHandler - http.Handler that receives updates sent by Telegram to the url set with SetWebhook.
+// It can be mounted in an existing server. Every request is answered with 200 OK as soon as the
+// update is decoded and queued, updates are processed one by one in a separate goroutine in the order
+// they were received. If the queue is full or Context is done the request is answered with 503 Service
+// Unavailable, so Telegram sends the update again later.
+//
+// Path			If not empty, only requests to this secret path are accepted
+// SecretToken	If not empty, requests must contain it in the X-Telegram-Bot-Api-Secret-Token header,
+//				use the same value in SetWebhookOpt.SecretToken
+// Handler		Optional. Called for every received update
+// Updates		Optional. Every received update is sent to this channel
+// Context		Optional. Passed to Handler, when it is done the processing of updates stops, even if it
+//				is blocked on sending to Updates
+// QueueSize	Optional. Number of received updates waiting for processing, defaults to 100
+// MaxBodySize	Optional. Maximum size of a request body in bytes, defaults to 1 MB
+type WebhookHandler struct {
+	Path        string
+	SecretToken string
+	Handler     UpdateHandler
+	Updates     chan<- Update
+	Context     context.Context
+	QueueSize   int
+	MaxBodySize int64
+
+	once  sync.Once
+	queue chan Update
+}
+
+// ServeHTTP - implements http.Handler
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if h.Path != "" && r.URL.Path != h.Path {
+		http.NotFound(w, r)
+		return
+	}
+	if h.SecretToken != "" &&
+		subtle.ConstantTimeCompare([]byte(r.Header.Get(webhookSecretHeader)), []byte(h.SecretToken)) != 1 {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	maxBodySize := h.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = webhookMaxBodySize
+	}
+	var update Update
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&update)
+	if err != nil {
+		errLog("WebhookHandler Decode", err)
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	h.once.Do(h.start)
+	ctx := h.context()
+	if ctx.Err() == nil {
+		select {
+		case h.queue <- update:
+			w.WriteHeader(http.StatusOK)
+			return
+		default:
+		}
+	}
+	errLog("WebhookHandler queue", errWebhookUnavailable)
+	http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+}
+
+func (h *WebhookHandler) context() context.Context {
+	if h.Context != nil {
+		return h.Context
+	}
+	return context.Background()
+}
+
+// start - create the queue and start the goroutine processing updates from it
+func (h *WebhookHandler) start() {
+	queueSize := h.QueueSize
+	if queueSize <= 0 {
+		queueSize = webhookQueueSize
+	}
+	h.queue = make(chan Update, queueSize)
+	go h.process(h.context())
+}
+
+func (h *WebhookHandler) process(ctx context.Context) {
+	for {
+		select {
+		case update := <-h.queue:
+			if h.Handler != nil {
+				h.Handler(ctx, update)
+			}
+			if h.Updates != nil {
+				select {
+				case h.Updates <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package telego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func postUpdate(h http.Handler, path, secret, body string) int {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if secret != "" {
+		r.Header.Set(webhookSecretHeader, secret)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestWebhookHandlerRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		secret string
+		body   string
+		want   int
+	}{
+		{"valid", http.MethodPost, "/hook", "token", `{"update_id":1}`, http.StatusOK},
+		{"get", http.MethodGet, "/hook", "token", "", http.StatusMethodNotAllowed},
+		{"wrong path", http.MethodPost, "/other", "token", `{"update_id":1}`, http.StatusNotFound},
+		{"wrong secret", http.MethodPost, "/hook", "other", `{"update_id":1}`, http.StatusForbidden},
+		{"no secret", http.MethodPost, "/hook", "", `{"update_id":1}`, http.StatusForbidden},
+		{"invalid json", http.MethodPost, "/hook", "token", `{`, http.StatusBadRequest},
+		{"too large", http.MethodPost, "/hook", "token", `{"update_id":1,"message":{"text":"` +
+			strings.Repeat("x", 2048) + `"}}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			h := &WebhookHandler{Path: "/hook", SecretToken: "token", Context: ctx, MaxBodySize: 1024}
+			r := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.secret != "" {
+				r.Header.Set(webhookSecretHeader, tt.secret)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookHandlerOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := make(chan Update)
+	var handled []int
+	h := &WebhookHandler{
+		Context: ctx,
+		Updates: updates,
+		Handler: func(ctx context.Context, update Update) { handled = append(handled, update.UpdateID) },
+	}
+	for i := 1; i <= 20; i++ {
+		if code := postUpdate(h, "/", "", `{"update_id":`+strconv.Itoa(i)+`}`); code != http.StatusOK {
+			t.Fatalf("status = %d, want 200", code)
+		}
+	}
+	for i := 1; i <= 20; i++ {
+		select {
+		case update := <-updates:
+			if update.UpdateID != i {
+				t.Fatalf("update %d received, want %d", update.UpdateID, i)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout")
+		}
+	}
+	for i, id := range handled {
+		if id != i+1 {
+			t.Fatalf("handled = %v, want in order", handled)
+		}
+	}
+}
+
+func TestWebhookHandlerFullQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := make(chan Update)
+	h := &WebhookHandler{Context: ctx, Updates: updates, QueueSize: 2}
+	codes := make(map[int]int)
+	for i := 1; i <= 5; i++ {
+		codes[postUpdate(h, "/", "", `{"update_id":`+strconv.Itoa(i)+`}`)]++
+	}
+	// one update is blocked on Updates, two are queued
+	if codes[http.StatusServiceUnavailable] < 2 || codes[http.StatusOK] < 2 {
+		t.Errorf("status codes = %v, want some updates rejected with 503", codes)
+	}
+
+	cancel()
+	if code := postUpdate(h, "/", "", `{"update_id":6}`); code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d after the context is done, want 503", code)
+	}
+}